@@ -0,0 +1,66 @@
+package bitshares
+
+import (
+	"github.com/denkhaus/bitshares/crypto"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
+)
+
+//UpdateAccountVotes broadcasts the vote changes collected by update
+//after validating them against the chain parameters.
+func (p *websocketAPI) UpdateAccountVotes(signer crypto.Signer, update *crypto.VoteUpdate, feeAsset types.GrapheneObject) error {
+	op, err := update.Operation()
+	if err != nil {
+		return errors.Annotate(err, "Operation")
+	}
+
+	props, err := p.GetGlobalProperties()
+	if err != nil {
+		return errors.Annotate(err, "GetGlobalProperties")
+	}
+
+	if err := update.Check(props); err != nil {
+		return errors.Annotate(err, "Check")
+	}
+
+	trx, err := p.BuildSignedTransaction(signer, feeAsset, op)
+	if err != nil {
+		return errors.Annotate(err, "BuildSignedTransaction")
+	}
+
+	if err := p.BroadcastTransaction(trx); err != nil {
+		return errors.Annotate(err, "BroadcastTransaction")
+	}
+
+	return nil
+}
+
+//UpdateAccountAuthorities broadcasts the changes collected by update. The update is refused
+//if it would leave an impossible authority, break the chain limits or take control away from
+//the keys of signer. Rotations to keys signer doesn't hold need crypto.AuthorityUpdate.AllowRotation.
+func (p *websocketAPI) UpdateAccountAuthorities(signer crypto.Signer, update *crypto.AuthorityUpdate, feeAsset types.GrapheneObject) error {
+	op, err := update.Operation()
+	if err != nil {
+		return errors.Annotate(err, "Operation")
+	}
+
+	props, err := p.GetGlobalProperties()
+	if err != nil {
+		return errors.Annotate(err, "GetGlobalProperties")
+	}
+
+	if err := update.Check(p.authorityChecker(), props, signer.Publics()); err != nil {
+		return errors.Annotate(err, "Check")
+	}
+
+	trx, err := p.BuildSignedTransaction(signer, feeAsset, op)
+	if err != nil {
+		return errors.Annotate(err, "BuildSignedTransaction")
+	}
+
+	if err := p.BroadcastTransaction(trx); err != nil {
+		return errors.Annotate(err, "BroadcastTransaction")
+	}
+
+	return nil
+}
@@ -7,7 +7,7 @@ import (
 	"net/http"
 	"time"
 
-	"github.com/denkhaus/logging"
+	"github.com/denkhaus/bitshares/util"
 	"github.com/juju/errors"
 	"github.com/pquerna/ffjson/ffjson"
 )
@@ -55,7 +55,7 @@ func (p *rpcClient) CallAPI(method string, args ...interface{}) (*json.RawMessag
 		return nil, errors.Annotate(err, "Encode")
 	}
 
-	logging.DDumpJSON("rpc req >", req)
+	util.DDumpJSON("rpc req >", req)
 
 	r, err := http.NewRequest("POST", p.endpointURL, p.encBuf)
 	if err != nil {
@@ -82,7 +82,7 @@ func (p *rpcClient) CallAPI(method string, args ...interface{}) (*json.RawMessag
 		return nil, ret.Error
 	}
 
-	logging.DDumpJSON("rpc resp <", ret.Result)
+	util.DDumpJSON("rpc resp <", ret.Result)
 	return ret.Result, nil
 }
 
@@ -11,6 +11,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/denkhaus/bitshares/util"
 	"github.com/denkhaus/logging"
 	"github.com/juju/errors"
 	"github.com/pquerna/ffjson/ffjson"
@@ -183,7 +184,7 @@ func (p *wsClient) receive() {
 			delete(p.pending, resp.ID)
 			p.mutex.Unlock()
 
-			logging.DDumpJSON("ws resp <", resp)
+			util.DDumpJSON("ws resp <", resp)
 
 			if resp.Error != nil {
 				call.Error = resp.Error
@@ -198,7 +199,7 @@ func (p *wsClient) receive() {
 				continue
 			}
 
-			logging.DDumpJSON("ws subscription resp <", subsResp)
+			util.DDumpJSON("ws subscription resp <", subsResp)
 
 			if subsResp.Method != "notice" {
 				p.errors <- errors.Errorf(
@@ -302,7 +303,7 @@ func (p *wsClient) Call(method string, args []interface{}) (*RPCCall, error) {
 	p.pending[call.Request.ID] = call
 	p.mutex.Unlock()
 
-	logging.DDumpJSON("ws req >", call.Request)
+	util.DDumpJSON("ws req >", call.Request)
 
 	if err := p.conn.SetDeadline(time.Now().Add(ReadWriteTimeout)); err != nil {
 		return nil, errors.Annotate(err, "SetDeadline")
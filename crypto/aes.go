@@ -0,0 +1,58 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+
+	"github.com/juju/errors"
+)
+
+var (
+	ErrInvalidPadding = errors.New("invalid padding")
+)
+
+//aesEncrypt encrypts plain like fc::aes_encrypt, using AES-256-CBC
+//with the first 32 bytes of seed as key and the next 16 bytes as IV.
+func aesEncrypt(seed []byte, plain []byte) ([]byte, error) {
+	blk, err := aes.NewCipher(seed[:32])
+	if err != nil {
+		return nil, errors.Annotate(err, "NewCipher")
+	}
+
+	cnt := aes.BlockSize - len(plain)%aes.BlockSize
+	buf := make([]byte, 0, len(plain)+cnt)
+	buf = append(buf, plain...)
+	buf = append(buf, bytes.Repeat([]byte{byte(cnt)}, cnt)...)
+
+	cipher.NewCBCEncrypter(blk, seed[32:48]).CryptBlocks(buf, buf)
+	return buf, nil
+}
+
+//aesDecrypt decrypts data encrypted by aesEncrypt.
+func aesDecrypt(seed []byte, data []byte) ([]byte, error) {
+	if len(data) == 0 || len(data)%aes.BlockSize != 0 {
+		return nil, ErrInvalidPadding
+	}
+
+	blk, err := aes.NewCipher(seed[:32])
+	if err != nil {
+		return nil, errors.Annotate(err, "NewCipher")
+	}
+
+	buf := make([]byte, len(data))
+	cipher.NewCBCDecrypter(blk, seed[32:48]).CryptBlocks(buf, data)
+
+	cnt := int(buf[len(buf)-1])
+	if cnt == 0 || cnt > aes.BlockSize {
+		return nil, ErrInvalidPadding
+	}
+
+	for _, b := range buf[len(buf)-cnt:] {
+		if int(b) != cnt {
+			return nil, ErrInvalidPadding
+		}
+	}
+
+	return buf[:len(buf)-cnt], nil
+}
@@ -0,0 +1,701 @@
+package crypto
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/denkhaus/bitshares/config"
+	"github.com/denkhaus/bitshares/operations"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
+)
+
+const (
+	//MaxSigCheckDepth is the nesting depth of account authorities graphene evaluates (GRAPHENE_MAX_SIG_CHECK_DEPTH).
+	MaxSigCheckDepth = 2
+
+	//AuthorityRoleOther marks an authority not bound to an account, e.g. a balance owner key.
+	AuthorityRoleOther = "other"
+
+	committeeAccount = "1.2.0"
+	tempAccount      = "1.2.4"
+)
+
+var (
+	ErrAccountNotFound      = errors.New("account not found")
+	ErrUnsupportedOperation = errors.New("operation not supported")
+)
+
+//AccountLookup returns the account with id including its owner and active authorities.
+type AccountLookup func(id string) (*types.Account, error)
+
+//AccountsLookup creates an AccountLookup serving accounts.
+func AccountsLookup(accounts types.Accounts) AccountLookup {
+	return func(id string) (*types.Account, error) {
+		for idx := range accounts {
+			if accounts[idx].ID.ID() == id {
+				return &accounts[idx], nil
+			}
+		}
+
+		return nil, errors.Annotate(ErrAccountNotFound, id)
+	}
+}
+
+//RequiredAuthorities holds the authorities a list of operations requires.
+type RequiredAuthorities struct {
+	Active types.AccountIDs
+	Owner  types.AccountIDs
+	Other  []types.Authority
+}
+
+func (p *RequiredAuthorities) addActive(ids ...types.AccountID) {
+	p.Active = appendAccountIDs(p.Active, ids...)
+}
+
+func (p *RequiredAuthorities) addOwner(ids ...types.AccountID) {
+	p.Owner = appendAccountIDs(p.Owner, ids...)
+}
+
+func (p *RequiredAuthorities) addKey(pub types.PublicKey) {
+	p.Other = append(p.Other, types.Authority{
+		WeightThreshold: 1,
+		AccountAuths:    types.AccountAuthsMap{},
+		KeyAuths:        types.KeyAuthsMap{&pub: 1},
+		AddressAuths:    types.AddressAuthsMap{},
+	})
+}
+
+func appendAccountIDs(ids types.AccountIDs, add ...types.AccountID) types.AccountIDs {
+	for _, id := range add {
+		found := false
+		for _, have := range ids {
+			if have.ID() == id.ID() {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+//GetRequiredAuthorities returns the authorities required by ops like graphene's operation_get_required_authorities.
+func GetRequiredAuthorities(ops types.Operations) (*RequiredAuthorities, error) {
+	req := RequiredAuthorities{}
+	for idx, op := range ops {
+		if err := req.add(op); err != nil {
+			return nil, errors.Annotatef(err, "operation %d", idx)
+		}
+	}
+
+	return &req, nil
+}
+
+func (p *RequiredAuthorities) add(op types.Operation) error {
+	switch op := op.(type) {
+	case *operations.TransferOperation:
+		p.addActive(op.From)
+	case *operations.LimitOrderCreateOperation:
+		p.addActive(op.Seller)
+	case *operations.LimitOrderCancelOperation:
+		p.addActive(op.FeePayingAccount)
+	case *operations.CallOrderUpdateOperation:
+		p.addActive(op.FundingAccount)
+	case *operations.AccountCreateOperation:
+		p.addActive(op.Registrar)
+	case *operations.AccountUpdateOperation:
+		//like account_update_operation::is_owner_update
+		if op.Owner != nil || op.Extensions.OwnerSpecialAuthority != nil {
+			p.addOwner(op.Account)
+		} else {
+			p.addActive(op.Account)
+		}
+	case *operations.AccountWhitelistOperation:
+		p.addActive(op.AuthorizingAccount)
+	case *operations.AccountUpgradeOperation:
+		p.addActive(op.AccountToUpgrade)
+	case *operations.AccountTransferOperation:
+		p.addActive(op.AccountID)
+	case *operations.AssetCreateOperation:
+		p.addActive(op.Issuer)
+	case *operations.AssetUpdateOperation:
+		p.addActive(op.Issuer)
+	case *operations.AssetUpdateBitassetOperation:
+		p.addActive(op.Issuer)
+	case *operations.AssetUpdateFeedProducersOperation:
+		p.addActive(op.Issuer)
+	case *operations.AssetIssueOperation:
+		p.addActive(op.Issuer)
+	case *operations.AssetReserveOperation:
+		p.addActive(op.Payer)
+	case *operations.AssetFundFeePoolOperation:
+		p.addActive(op.FromAccount)
+	case *operations.AssetSettleOperation:
+		p.addActive(op.Account)
+	case *operations.AssetGlobalSettleOperation:
+		p.addActive(op.Issuer)
+	case *operations.AssetPublishFeedOperation:
+		p.addActive(op.Publisher)
+	case *operations.AssetClaimFeesOperation:
+		p.addActive(op.Issuer)
+	case *operations.OverrideTransferOperation:
+		p.addActive(op.Issuer)
+	case *operations.WitnessCreateOperation:
+		p.addActive(op.WitnessAccount)
+	case *operations.WitnessUpdateOperation:
+		p.addActive(op.WitnessAccount)
+	case *operations.ProposalCreateOperation:
+		p.addActive(op.FeePayingAccount)
+	case *operations.ProposalUpdateOperation:
+		p.addActive(op.FeePayingAccount)
+		p.addActive(op.ActiveApprovalsToAdd...)
+		p.addActive(op.ActiveApprovalsToRemove...)
+		p.addOwner(op.OwnerApprovalsToAdd...)
+		p.addOwner(op.OwnerApprovalsToRemove...)
+		for _, pub := range op.KeyApprovalsToAdd {
+			p.addKey(pub)
+		}
+		for _, pub := range op.KeyApprovalsToRemove {
+			p.addKey(pub)
+		}
+	case *operations.ProposalDeleteOperation:
+		if op.UsingOwnerAuthority {
+			p.addOwner(op.FeePayingAccount)
+		} else {
+			p.addActive(op.FeePayingAccount)
+		}
+	case *operations.WithdrawPermissionCreateOperation:
+		p.addActive(op.WithdrawFromAccount)
+	case *operations.WithdrawPermissionUpdateOperation:
+		p.addActive(op.WithdrawFromAccount)
+	case *operations.WithdrawPermissionClaimOperation:
+		p.addActive(op.WithdrawToAccount)
+	case *operations.WithdrawPermissionDeleteOperation:
+		p.addActive(op.WithdrawFromAccount)
+	case *operations.CommitteeMemberCreateOperation:
+		p.addActive(op.CommitteeMemberAccount)
+	case *operations.CommitteeMemberUpdateOperation:
+		p.addActive(op.CommitteeMemberAccount)
+	case *operations.CommitteeMemberUpdateGlobalParametersOperation:
+		p.addActive(types.AccountIDFromObject(types.NewAccountID(committeeAccount)))
+	case *operations.VestingBalanceCreateOperation:
+		p.addActive(op.Creator)
+	case *operations.VestingBalanceWithdrawOperation:
+		p.addActive(op.Owner)
+	case *operations.WorkerCreateOperation:
+		p.addActive(op.Owner)
+	case *operations.CustomOperation:
+		p.addActive(op.Payer)
+		p.addActive(op.RequiredAuths...)
+	case *operations.BalanceClaimOperation:
+		//the deposit account pays no fee, only the balance key has to sign
+		p.addKey(op.BalanceOwnerKey)
+	case *operations.TransferToBlindOperation:
+		p.addActive(op.From)
+	case *operations.TransferFromBlindOperation:
+		//the fee is paid by the temp account, which has no authority
+		for _, in := range op.BlindInputs {
+			p.Other = append(p.Other, in.Owner)
+		}
+	case *operations.FillOrderOperation:
+		//virtual operation
+	case nil:
+		return ErrUnsupportedOperation
+	default:
+		//AssertOperation lacks fee_paying_account and required_auths
+		return errors.Annotatef(ErrUnsupportedOperation, "%s", op.Type())
+	}
+
+	return nil
+}
+
+//MissingAuthority describes a required authority the signatures don't satisfy.
+//Account is nil for authorities of role AuthorityRoleOther.
+//Keys lists the keys of the authority and its nested accounts that did not sign.
+type MissingAuthority struct {
+	Account   *types.AccountID
+	Role      string
+	Authority types.Authority
+	Keys      types.PublicKeys
+}
+
+//AuthorityReport is the result of an AuthorityChecker evaluation.
+//Unused signatures make the chain reject a transaction as unnecessary,
+//so Satisfied is false for signatures if Unused isn't empty.
+type AuthorityReport struct {
+	Satisfied bool
+	Missing   []MissingAuthority
+	Used      types.PublicKeys
+	Unused    types.PublicKeys
+}
+
+//AuthorityChecker evaluates signatures against the weighted authorities of a transaction offline.
+type AuthorityChecker struct {
+	lookup   AccountLookup
+	accounts map[string]*types.Account
+	MaxDepth int
+}
+
+//NewAuthorityChecker creates an AuthorityChecker fetching accounts through lookup.
+//Fetched accounts are cached for the lifetime of the checker.
+func NewAuthorityChecker(lookup AccountLookup) *AuthorityChecker {
+	return &AuthorityChecker{
+		lookup:   lookup,
+		accounts: make(map[string]*types.Account),
+		MaxDepth: MaxSigCheckDepth,
+	}
+}
+
+func (p *AuthorityChecker) account(id string) (*types.Account, error) {
+	if acct, ok := p.accounts[id]; ok {
+		return acct, nil
+	}
+
+	acct, err := p.lookup(id)
+	if err != nil {
+		return nil, errors.Annotatef(err, "lookup %s", id)
+	}
+
+	p.accounts[id] = acct
+	return acct, nil
+}
+
+//Check evaluates whether keys satisfy all authorities required by tx
+//and reports missing authorities and keys not needed for that. Like the chain,
+//tx signed by keys is not satisfied if any of keys is not needed.
+func (p *AuthorityChecker) Check(tx *types.SignedTransaction, keys types.PublicKeys) (*AuthorityReport, error) {
+	state, err := newSignState(p, keys, nil)
+	if err != nil {
+		return nil, errors.Annotate(err, "newSignState")
+	}
+
+	report, err := p.evaluate(state, tx.Operations)
+	if err != nil {
+		return nil, errors.Annotate(err, "evaluate")
+	}
+
+	state.classify(report)
+	report.Satisfied = report.Satisfied && len(report.Unused) == 0
+	return report, nil
+}
+
+//CheckProposal evaluates whether the approvals collected by proposal satisfy the authorities
+//required by its proposed operations, like graphene's is_authorized_to_execute.
+//Used and Unused of the report refer to the key approvals. Like verify_authority, unused key approvals
+//leave the proposal unsatisfied.
+func (p *AuthorityChecker) CheckProposal(proposal *types.Proposal) (*AuthorityReport, error) {
+	state, err := newSignState(p, proposal.AvailableKeyApprovals, nil)
+	if err != nil {
+		return nil, errors.Annotate(err, "newSignState")
+	}
+
+	//approving accounts count as signed in nested authorities, like graphene's approved_by
+	for _, id := range proposal.AvailableActiveApprovals {
+		state.approved[id.ID()] = true
+	}
+	for _, id := range proposal.AvailableOwnerApprovals {
+		state.approved[id.ID()] = true
+		state.ownerApproved[id.ID()] = true
+	}
+
+	report, err := p.evaluate(state, proposal.ProposedTransaction.Operations)
+	if err != nil {
+		return nil, errors.Annotate(err, "evaluate")
+	}
+
+	state.classify(report)
+	report.Satisfied = report.Satisfied && len(report.Unused) == 0
+	return report, nil
+}
+
+//CheckSignatures evaluates the signatures present on tx like Check.
+func (p *AuthorityChecker) CheckSignatures(tx *types.SignedTransaction, chain *config.ChainConfig) (*AuthorityReport, error) {
+	keys, err := SignatureKeys(tx, chain)
+	if err != nil {
+		return nil, errors.Annotate(err, "SignatureKeys")
+	}
+
+	return p.Check(tx, keys)
+}
+
+//Satisfies evaluates whether keys satisfy auth, following nested account authorities.
+func (p *AuthorityChecker) Satisfies(auth *types.Authority, keys types.PublicKeys) (bool, error) {
+	state, err := newSignState(p, keys, nil)
+	if err != nil {
+		return false, errors.Annotate(err, "newSignState")
+	}
+
+	return state.checkAuthority(auth, 0)
+}
+
+//RequiredSignatures returns the subset of available keys that has to sign tx in addition to
+//its present signatures, the offline equivalent of get_required_signatures.
+//The report tells whether the selected keys satisfy all authorities.
+func (p *AuthorityChecker) RequiredSignatures(tx *types.SignedTransaction, available types.PublicKeys,
+	chain *config.ChainConfig) (types.PublicKeys, *AuthorityReport, error) {
+	signed, err := SignatureKeys(tx, chain)
+	if err != nil {
+		return nil, nil, errors.Annotate(err, "SignatureKeys")
+	}
+
+	state, err := newSignState(p, signed, available)
+	if err != nil {
+		return nil, nil, errors.Annotate(err, "newSignState")
+	}
+
+	report, err := p.evaluate(state, tx.Operations)
+	if err != nil {
+		return nil, nil, errors.Annotate(err, "evaluate")
+	}
+
+	ret := types.PublicKeys{}
+	for _, key := range state.provided {
+		if !key.used {
+			continue
+		}
+
+		report.Used = append(report.Used, *key.pub)
+		if !key.signed {
+			ret = append(ret, *key.pub)
+		}
+	}
+
+	return ret, report, nil
+}
+
+func (p *AuthorityChecker) evaluate(state *signState, ops types.Operations) (*AuthorityReport, error) {
+	req, err := GetRequiredAuthorities(ops)
+	if err != nil {
+		return nil, errors.Annotate(err, "GetRequiredAuthorities")
+	}
+
+	report := AuthorityReport{}
+	for idx := range req.Other {
+		ok, err := state.checkAuthority(&req.Other[idx], 0)
+		if err != nil {
+			return nil, errors.Annotate(err, "checkAuthority [other]")
+		}
+
+		if !ok {
+			report.Missing = append(report.Missing, state.missing(nil, AuthorityRoleOther, req.Other[idx]))
+		}
+	}
+
+	for idx := range req.Active {
+		id := req.Active[idx]
+		if id.ID() == tempAccount || state.approved[id.ID()] {
+			continue
+		}
+
+		acct, err := p.account(id.ID())
+		if err != nil {
+			return nil, errors.Annotate(err, "account")
+		}
+
+		//the owner authority satisfies active requirements too
+		ok, err := state.checkAuthority(&acct.Active, 0)
+		if err == nil && !ok {
+			ok, err = state.checkAuthority(&acct.Owner, 0)
+		}
+		if err != nil {
+			return nil, errors.Annotate(err, "checkAuthority [active]")
+		}
+
+		if ok {
+			state.approved[id.ID()] = true
+		} else {
+			report.Missing = append(report.Missing, state.missing(&id, KeyRoleActive, acct.Active))
+		}
+	}
+
+	for idx := range req.Owner {
+		id := req.Owner[idx]
+		if state.ownerApproved[id.ID()] {
+			continue
+		}
+
+		acct, err := p.account(id.ID())
+		if err != nil {
+			return nil, errors.Annotate(err, "account")
+		}
+
+		ok, err := state.checkAuthority(&acct.Owner, 0)
+		if err != nil {
+			return nil, errors.Annotate(err, "checkAuthority [owner]")
+		}
+
+		if !ok {
+			report.Missing = append(report.Missing, state.missing(&id, KeyRoleOwner, acct.Owner))
+		}
+	}
+
+	report.Satisfied = len(report.Missing) == 0
+	return &report, nil
+}
+
+//SignatureKeys recovers the public keys of the signatures present on tx.
+func SignatureKeys(tx *types.SignedTransaction, chain *config.ChainConfig) (types.PublicKeys, error) {
+	if len(tx.Signatures) == 0 {
+		return types.PublicKeys{}, nil
+	}
+
+	dig, err := tx.Digest(chain)
+	if err != nil {
+		return nil, errors.Annotate(err, "Digest")
+	}
+
+	ret := make(types.PublicKeys, 0, len(tx.Signatures))
+	for _, signature := range tx.Signatures {
+		p, _, err := btcec.RecoverCompact(btcec.S256(), signature.Bytes(), dig)
+		if err != nil {
+			return nil, errors.Annotate(err, "RecoverCompact")
+		}
+
+		pub, err := types.NewPublicKey(p)
+		if err != nil {
+			return nil, errors.Annotate(err, "NewPublicKey")
+		}
+
+		ret = append(ret, *pub)
+	}
+
+	return ret, nil
+}
+
+type signKey struct {
+	pub    *types.PublicKey
+	addr   []byte
+	signed bool
+	used   bool
+}
+
+//signState mirrors graphene's sign_state. Keys are consumed from available only if needed.
+type signState struct {
+	checker   *AuthorityChecker
+	provided  []*signKey
+	available []*signKey
+	approved  map[string]bool
+	//ownerApproved holds the accounts whose owner authority approved a proposal
+	ownerApproved map[string]bool
+}
+
+func newSignState(checker *AuthorityChecker, signed, available types.PublicKeys) (*signState, error) {
+	state := signState{
+		checker:       checker,
+		approved:      make(map[string]bool),
+		ownerApproved: make(map[string]bool),
+	}
+
+	for idx := range signed {
+		key, err := newSignKey(&signed[idx])
+		if err != nil {
+			return nil, errors.Annotate(err, "newSignKey")
+		}
+
+		key.signed = true
+		if state.find(state.provided, key.pub, nil) == nil {
+			state.provided = append(state.provided, key)
+		}
+	}
+
+	for idx := range available {
+		key, err := newSignKey(&available[idx])
+		if err != nil {
+			return nil, errors.Annotate(err, "newSignKey")
+		}
+
+		state.available = append(state.available, key)
+	}
+
+	return &state, nil
+}
+
+//classify sorts the provided keys into used and unused ones of report.
+func (p *signState) classify(report *AuthorityReport) {
+	for _, key := range p.provided {
+		if key.used {
+			report.Used = append(report.Used, *key.pub)
+		} else {
+			report.Unused = append(report.Unused, *key.pub)
+		}
+	}
+}
+
+func newSignKey(pub *types.PublicKey) (*signKey, error) {
+	addr, err := types.NewAddress(pub)
+	if err != nil {
+		return nil, errors.Annotate(err, "NewAddress")
+	}
+
+	return &signKey{pub: pub, addr: addr.Bytes()}, nil
+}
+
+func (p *signState) find(keys []*signKey, pub *types.PublicKey, addr []byte) *signKey {
+	for _, key := range keys {
+		if pub != nil && key.pub.Equal(pub) {
+			return key
+		}
+		if addr != nil && bytes.Equal(key.addr, addr) {
+			return key
+		}
+	}
+
+	return nil
+}
+
+func (p *signState) signedBy(pub *types.PublicKey, addr []byte) bool {
+	key := p.find(p.provided, pub, addr)
+	if key == nil {
+		key = p.find(p.available, pub, addr)
+		if key == nil {
+			return false
+		}
+
+		p.provided = append(p.provided, key)
+	}
+
+	key.used = true
+	return true
+}
+
+func (p *signState) checkAuthority(auth *types.Authority, depth int) (bool, error) {
+	threshold := uint64(auth.WeightThreshold)
+	total := uint64(0)
+
+	for _, pub := range sortedKeys(auth.KeyAuths) {
+		if p.signedBy(pub, nil) {
+			total += uint64(auth.KeyAuths[pub])
+			if total >= threshold {
+				return true, nil
+			}
+		}
+	}
+
+	for _, addr := range sortedAddresses(auth.AddressAuths) {
+		if p.signedBy(nil, addr.Bytes()) {
+			total += uint64(auth.AddressAuths[addr])
+			if total >= threshold {
+				return true, nil
+			}
+		}
+	}
+
+	for _, ob := range sortedAccounts(auth.AccountAuths) {
+		id := ob.ID()
+		if !p.approved[id] {
+			if depth == p.checker.MaxDepth {
+				continue
+			}
+
+			acct, err := p.checker.account(id)
+			if err != nil {
+				return false, errors.Annotate(err, "account")
+			}
+
+			ok, err := p.checkAuthority(&acct.Active, depth+1)
+			if err != nil {
+				return false, err
+			}
+			if !ok {
+				continue
+			}
+
+			p.approved[id] = true
+		}
+
+		total += uint64(auth.AccountAuths[ob])
+		if total >= threshold {
+			return true, nil
+		}
+	}
+
+	return total >= threshold, nil
+}
+
+func (p *signState) missing(id *types.AccountID, role string, auth types.Authority) MissingAuthority {
+	ret := MissingAuthority{
+		Account:   id,
+		Role:      role,
+		Authority: auth,
+		Keys:      types.PublicKeys{},
+	}
+
+	p.collectKeys(&ret.Keys, &auth, 0)
+	return ret
+}
+
+func (p *signState) collectKeys(keys *types.PublicKeys, auth *types.Authority, depth int) {
+	for _, pub := range sortedKeys(auth.KeyAuths) {
+		if key := p.find(p.provided, pub, nil); key != nil && key.signed {
+			continue
+		}
+
+		known := false
+		for idx := range *keys {
+			if (*keys)[idx].Equal(pub) {
+				known = true
+				break
+			}
+		}
+
+		if !known {
+			*keys = append(*keys, *pub)
+		}
+	}
+
+	if depth == p.checker.MaxDepth {
+		return
+	}
+
+	for _, ob := range sortedAccounts(auth.AccountAuths) {
+		if acct, err := p.checker.account(ob.ID()); err == nil {
+			p.collectKeys(keys, &acct.Active, depth+1)
+		}
+	}
+}
+
+//graphene stores authorities in flat_maps, so evaluation order is the sorted key order.
+func sortedKeys(auths types.KeyAuthsMap) []*types.PublicKey {
+	ret := make([]*types.PublicKey, 0, len(auths))
+	for pub := range auths {
+		ret = append(ret, pub)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return bytes.Compare(ret[i].Bytes(), ret[j].Bytes()) < 0
+	})
+
+	return ret
+}
+
+func sortedAddresses(auths types.AddressAuthsMap) []*types.Address {
+	ret := make([]*types.Address, 0, len(auths))
+	for addr := range auths {
+		ret = append(ret, addr)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return bytes.Compare(ret[i].Bytes(), ret[j].Bytes()) < 0
+	})
+
+	return ret
+}
+
+func sortedAccounts(auths types.AccountAuthsMap) []types.GrapheneObject {
+	ret := make([]types.GrapheneObject, 0, len(auths))
+	for ob := range auths {
+		ret = append(ret, ob)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Instance() < ret[j].Instance()
+	})
+
+	return ret
+}
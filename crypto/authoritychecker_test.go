@@ -0,0 +1,271 @@
+package crypto
+
+import (
+	"testing"
+	"time"
+
+	"github.com/denkhaus/bitshares/config"
+	"github.com/denkhaus/bitshares/operations"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func accountID(id string) types.AccountID {
+	return types.AccountIDFromObject(types.NewAccountID(id))
+}
+
+func keyAuthority(threshold types.UInt32, keys ...*types.PrivateKey) types.Authority {
+	auth := types.Authority{
+		WeightThreshold: threshold,
+		AccountAuths:    types.AccountAuthsMap{},
+		KeyAuths:        types.KeyAuthsMap{},
+		AddressAuths:    types.AddressAuthsMap{},
+	}
+
+	for _, key := range keys {
+		auth.KeyAuths[key.PublicKey()] = 1
+	}
+
+	return auth
+}
+
+func accountAuthority(id string) types.Authority {
+	auth := keyAuthority(1)
+	auth.AccountAuths[types.NewAccountID(id)] = 1
+	return auth
+}
+
+func transferFrom(from string) *types.SignedTransaction {
+	tx := types.NewSignedTransaction()
+	tx.Expiration.Set(time.Minute)
+	tx.Operations = types.Operations{
+		&operations.TransferOperation{
+			OperationFee: types.OperationFee{Fee: &types.AssetAmount{
+				Asset: types.AssetIDFromObject(types.NewAssetID("1.3.0")),
+			}},
+			From:       accountID(from),
+			To:         accountID("1.2.999"),
+			Extensions: types.Extensions{},
+		},
+	}
+
+	return tx
+}
+
+func publics(keys ...*types.PrivateKey) types.PublicKeys {
+	ret := types.PublicKeys{}
+	for _, key := range keys {
+		ret = append(ret, *key.PublicKey())
+	}
+
+	return ret
+}
+
+func TestAuthorityChecker(t *testing.T) {
+	config.SetCurrent(config.ChainIDGPH)
+
+	keys := make([]*types.PrivateKey, 4)
+	for idx := range keys {
+		key, err := types.GeneratePrivateKey()
+		if !assert.NoError(t, err) {
+			return
+		}
+		keys[idx] = key
+	}
+
+	accounts := types.Accounts{
+		{ID: accountID("1.2.100"), Active: keyAuthority(2, keys[0], keys[1]), Owner: keyAuthority(1, keys[2])},
+		{ID: accountID("1.2.200"), Active: accountAuthority("1.2.100"), Owner: keyAuthority(1, keys[3])},
+		{ID: accountID("1.2.300"), Active: accountAuthority("1.2.200"), Owner: keyAuthority(1, keys[3])},
+		{ID: accountID("1.2.400"), Active: accountAuthority("1.2.300"), Owner: keyAuthority(1, keys[3])},
+	}
+
+	checker := NewAuthorityChecker(AccountsLookup(accounts))
+
+	// multisig threshold not reached
+	report, err := checker.Check(transferFrom("1.2.100"), publics(keys[0]))
+	if assert.NoError(t, err) && assert.False(t, report.Satisfied) && assert.Len(t, report.Missing, 1) {
+		missing := report.Missing[0]
+		assert.Equal(t, "1.2.100", missing.Account.ID())
+		assert.Equal(t, KeyRoleActive, missing.Role)
+		assert.Equal(t, publics(keys[1]), missing.Keys)
+	}
+
+	// threshold reached, but the chain rejects the unnecessary signature
+	report, err = checker.Check(transferFrom("1.2.100"), publics(keys[0], keys[1], keys[3]))
+	if assert.NoError(t, err) {
+		assert.False(t, report.Satisfied)
+		assert.Empty(t, report.Missing)
+		assert.Len(t, report.Used, 2)
+		assert.Equal(t, publics(keys[3]), report.Unused)
+	}
+
+	// owner satisfies active
+	report, err = checker.Check(transferFrom("1.2.100"), publics(keys[2]))
+	if assert.NoError(t, err) {
+		assert.True(t, report.Satisfied)
+	}
+
+	// nested account authorities up to the maximum depth
+	report, err = checker.Check(transferFrom("1.2.300"), publics(keys[0], keys[1]))
+	if assert.NoError(t, err) {
+		assert.True(t, report.Satisfied)
+	}
+
+	report, err = checker.Check(transferFrom("1.2.400"), publics(keys[0], keys[1]))
+	if assert.NoError(t, err) {
+		assert.False(t, report.Satisfied)
+		assert.Equal(t, publics(keys[0], keys[1]), report.Unused)
+	}
+
+	// owner update requires the owner authority
+	update := transferFrom("1.2.100")
+	owner := keyAuthority(1, keys[3])
+	update.Operations = types.Operations{
+		&operations.AccountUpdateOperation{Account: accountID("1.2.100"), Owner: &owner},
+	}
+
+	report, err = checker.Check(update, publics(keys[0], keys[1]))
+	if assert.NoError(t, err) && assert.Len(t, report.Missing, 1) {
+		assert.Equal(t, KeyRoleOwner, report.Missing[0].Role)
+		assert.Equal(t, publics(keys[2]), report.Missing[0].Keys)
+	}
+
+	// so does an owner special authority
+	update.Operations = types.Operations{
+		&operations.AccountUpdateOperation{
+			Account: accountID("1.2.100"),
+			Extensions: types.AccountUpdateExtensions{
+				OwnerSpecialAuthority: &types.OwnerSpecialAuthority{},
+			},
+		},
+	}
+
+	report, err = checker.Check(update, publics(keys[0], keys[1]))
+	if assert.NoError(t, err) && assert.Len(t, report.Missing, 1) {
+		assert.Equal(t, KeyRoleOwner, report.Missing[0].Role)
+	}
+
+	report, err = checker.Check(update, publics(keys[2]))
+	if assert.NoError(t, err) {
+		assert.True(t, report.Satisfied)
+	}
+}
+
+func TestRequiredSignatures(t *testing.T) {
+	config.SetCurrent(config.ChainIDGPH)
+
+	keys := make([]*types.PrivateKey, 3)
+	for idx := range keys {
+		key, err := types.GeneratePrivateKey()
+		if !assert.NoError(t, err) {
+			return
+		}
+		keys[idx] = key
+	}
+
+	accounts := types.Accounts{
+		{ID: accountID("1.2.100"), Active: keyAuthority(2, keys[0], keys[1]), Owner: keyAuthority(1, keys[2])},
+	}
+
+	checker := NewAuthorityChecker(AccountsLookup(accounts))
+	available := publics(keys...)
+
+	tx := transferFrom("1.2.100")
+	required, report, err := checker.RequiredSignatures(tx, available, config.Current())
+	if assert.NoError(t, err) {
+		assert.True(t, report.Satisfied)
+		assert.Len(t, required, 2)
+		assert.NotContains(t, required, *keys[2].PublicKey())
+	}
+
+	if !assert.NoError(t, NewTransactionSigner(tx).Sign(types.PrivateKeys{*keys[0]}, config.Current())) {
+		return
+	}
+
+	required, report, err = checker.RequiredSignatures(tx, available, config.Current())
+	if assert.NoError(t, err) {
+		assert.True(t, report.Satisfied)
+		assert.Equal(t, publics(keys[1]), required)
+	}
+
+	report, err = checker.CheckSignatures(tx, config.Current())
+	if assert.NoError(t, err) {
+		assert.False(t, report.Satisfied)
+	}
+
+	_, err = checker.Check(transferFrom("1.2.500"), available)
+	assert.Error(t, err)
+}
+
+func TestCheckProposal(t *testing.T) {
+	config.SetCurrent(config.ChainIDGPH)
+
+	keys := make([]*types.PrivateKey, 3)
+	for idx := range keys {
+		key, err := types.GeneratePrivateKey()
+		if !assert.NoError(t, err) {
+			return
+		}
+		keys[idx] = key
+	}
+
+	accounts := types.Accounts{
+		{ID: accountID("1.2.100"), Active: keyAuthority(2, keys[0], keys[1]), Owner: keyAuthority(1, keys[2])},
+		{ID: accountID("1.2.200"), Active: accountAuthority("1.2.100"), Owner: keyAuthority(1, keys[2])},
+	}
+
+	checker := NewAuthorityChecker(AccountsLookup(accounts))
+	proposal := types.Proposal{
+		ProposedTransaction: transferFrom("1.2.200").Transaction,
+	}
+
+	// no approvals yet
+	report, err := checker.CheckProposal(&proposal)
+	if assert.NoError(t, err) && assert.Len(t, report.Missing, 1) {
+		assert.False(t, report.Satisfied)
+		assert.Equal(t, "1.2.200", report.Missing[0].Account.ID())
+	}
+
+	// a single key approval does not reach the threshold of the nested account
+	proposal.AvailableKeyApprovals = publics(keys[0])
+	report, err = checker.CheckProposal(&proposal)
+	if assert.NoError(t, err) {
+		assert.False(t, report.Satisfied)
+	}
+
+	// the active approval of the nested account satisfies its authority, the key approval is unused
+	proposal.AvailableActiveApprovals = types.AccountIDs{accountID("1.2.100")}
+	report, err = checker.CheckProposal(&proposal)
+	if assert.NoError(t, err) {
+		assert.False(t, report.Satisfied)
+		assert.Empty(t, report.Missing)
+		assert.Equal(t, publics(keys[0]), report.Unused)
+	}
+
+	proposal.AvailableKeyApprovals = nil
+	report, err = checker.CheckProposal(&proposal)
+	if assert.NoError(t, err) {
+		assert.True(t, report.Satisfied)
+		assert.Empty(t, report.Unused)
+	}
+
+	// owner updates need the owner approval of the account itself
+	owner := keyAuthority(1, keys[1])
+	proposal.ProposedTransaction.Operations = types.Operations{
+		&operations.AccountUpdateOperation{Account: accountID("1.2.200"), Owner: &owner},
+	}
+	proposal.AvailableKeyApprovals = nil
+	proposal.AvailableActiveApprovals = types.AccountIDs{accountID("1.2.200")}
+
+	report, err = checker.CheckProposal(&proposal)
+	if assert.NoError(t, err) && assert.Len(t, report.Missing, 1) {
+		assert.Equal(t, KeyRoleOwner, report.Missing[0].Role)
+	}
+
+	proposal.AvailableOwnerApprovals = types.AccountIDs{accountID("1.2.200")}
+	report, err = checker.CheckProposal(&proposal)
+	if assert.NoError(t, err) {
+		assert.True(t, report.Satisfied)
+	}
+}
@@ -0,0 +1,305 @@
+package crypto
+
+import (
+	"github.com/denkhaus/bitshares/operations"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
+)
+
+var (
+	ErrInvalidAuthorityRole   = errors.New("role must be owner or active")
+	ErrNoAuthorityChanges     = errors.New("no authority changes")
+	ErrImpossibleAuthority    = errors.New("authority weights can't reach the threshold")
+	ErrZeroAuthorityThreshold = errors.New("authority threshold must not be zero")
+	ErrAuthorityLockout       = errors.New("update locks out the available keys")
+	ErrAuthorityMembership    = errors.New("authority exceeds the maximum authority membership")
+)
+
+//AuthorityUpdate collects changes to the owner and active authorities and the memo key of an account
+//and turns them into an AccountUpdateOperation. Authorities not changed are left out of the operation.
+type AuthorityUpdate struct {
+	account *types.Account
+	owner   *types.Authority
+	active  *types.Authority
+	memoKey  *types.PublicKey
+	rotation bool
+	err      error
+}
+
+//NewAuthorityUpdate creates an AuthorityUpdate based on the current state of account.
+func NewAuthorityUpdate(account *types.Account) *AuthorityUpdate {
+	return &AuthorityUpdate{account: account}
+}
+
+//authority returns the updated authority of role, copied from the account on first use.
+func (p *AuthorityUpdate) authority(role string) *types.Authority {
+	var auth **types.Authority
+	var current *types.Authority
+
+	switch role {
+	case KeyRoleOwner:
+		auth, current = &p.owner, &p.account.Owner
+	case KeyRoleActive:
+		auth, current = &p.active, &p.account.Active
+	default:
+		if p.err == nil {
+			p.err = errors.Annotate(ErrInvalidAuthorityRole, role)
+		}
+		return nil
+	}
+
+	if *auth == nil {
+		*auth = copyAuthority(current)
+	}
+
+	return *auth
+}
+
+func copyAuthority(auth *types.Authority) *types.Authority {
+	ret := types.Authority{
+		WeightThreshold: auth.WeightThreshold,
+		AccountAuths:    types.AccountAuthsMap{},
+		KeyAuths:        types.KeyAuthsMap{},
+		AddressAuths:    types.AddressAuthsMap{},
+	}
+
+	for ob, weight := range auth.AccountAuths {
+		ret.AccountAuths[ob] = weight
+	}
+	for pub, weight := range auth.KeyAuths {
+		ret.KeyAuths[pub] = weight
+	}
+	for addr, weight := range auth.AddressAuths {
+		ret.AddressAuths[addr] = weight
+	}
+
+	return &ret
+}
+
+//AddKey adds pub with weight to the authority of role or changes the weight of pub if present.
+func (p *AuthorityUpdate) AddKey(role string, pub types.PublicKey, weight types.UInt16) *AuthorityUpdate {
+	if auth := p.authority(role); auth != nil {
+		for key := range auth.KeyAuths {
+			if key.Equal(&pub) {
+				delete(auth.KeyAuths, key)
+			}
+		}
+
+		auth.KeyAuths[&pub] = weight
+	}
+
+	return p
+}
+
+//RemoveKey removes pub from the authority of role.
+func (p *AuthorityUpdate) RemoveKey(role string, pub types.PublicKey) *AuthorityUpdate {
+	if auth := p.authority(role); auth != nil {
+		for key := range auth.KeyAuths {
+			if key.Equal(&pub) {
+				delete(auth.KeyAuths, key)
+			}
+		}
+	}
+
+	return p
+}
+
+//AddAccount adds account with weight to the authority of role or changes its weight if present.
+func (p *AuthorityUpdate) AddAccount(role string, account types.GrapheneObject, weight types.UInt16) *AuthorityUpdate {
+	if auth := p.authority(role); auth != nil {
+		p.removeAccount(auth, account)
+
+		id := types.AccountIDFromObject(account)
+		auth.AccountAuths[&id] = weight
+	}
+
+	return p
+}
+
+//RemoveAccount removes account from the authority of role.
+func (p *AuthorityUpdate) RemoveAccount(role string, account types.GrapheneObject) *AuthorityUpdate {
+	if auth := p.authority(role); auth != nil {
+		p.removeAccount(auth, account)
+	}
+
+	return p
+}
+
+func (p *AuthorityUpdate) removeAccount(auth *types.Authority, account types.GrapheneObject) {
+	for ob := range auth.AccountAuths {
+		if ob.ID() == account.ID() {
+			delete(auth.AccountAuths, ob)
+		}
+	}
+}
+
+//SetThreshold sets the weight threshold of the authority of role.
+func (p *AuthorityUpdate) SetThreshold(role string, threshold types.UInt32) *AuthorityUpdate {
+	if auth := p.authority(role); auth != nil {
+		auth.WeightThreshold = threshold
+	}
+
+	return p
+}
+
+//SetMemoKey rotates the memo key of the account to pub.
+func (p *AuthorityUpdate) SetMemoKey(pub types.PublicKey) *AuthorityUpdate {
+	p.memoKey = &pub
+	return p
+}
+
+//AllowRotation makes Check count the keys the update adds to an authority as held, so rotating to
+//a key the caller doesn't sign with passes. Holding the new key is up to the caller then.
+func (p *AuthorityUpdate) AllowRotation() *AuthorityUpdate {
+	p.rotation = true
+	return p
+}
+
+//Operation validates the changes and returns the AccountUpdateOperation applying them.
+func (p *AuthorityUpdate) Operation() (*operations.AccountUpdateOperation, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	if p.owner == nil && p.active == nil && p.memoKey == nil {
+		return nil, ErrNoAuthorityChanges
+	}
+
+	op := operations.AccountUpdateOperation{
+		Account:    p.account.ID,
+		Extensions: types.AccountUpdateExtensions{},
+		Owner:      p.owner,
+		Active:     p.active,
+	}
+
+	if p.owner != nil {
+		if err := validateAuthority(p.owner); err != nil {
+			return nil, errors.Annotate(err, KeyRoleOwner)
+		}
+	}
+
+	if p.active != nil {
+		if err := validateAuthority(p.active); err != nil {
+			return nil, errors.Annotate(err, KeyRoleActive)
+		}
+	}
+
+	if p.memoKey != nil {
+		options := p.account.Options
+		options.MemoKey = *p.memoKey
+		op.NewOptions = &options
+	}
+
+	return &op, nil
+}
+
+//validateAuthority mirrors the checks account_update_operation::validate applies to new authorities.
+func validateAuthority(auth *types.Authority) error {
+	if auth.WeightThreshold == 0 {
+		return ErrZeroAuthorityThreshold
+	}
+
+	if len(auth.AddressAuths) > 0 {
+		return errors.New("address authorities are not allowed")
+	}
+
+	total := uint64(0)
+	for _, weight := range auth.AccountAuths {
+		total += uint64(weight)
+	}
+	for _, weight := range auth.KeyAuths {
+		total += uint64(weight)
+	}
+
+	if total < uint64(auth.WeightThreshold) {
+		return ErrImpossibleAuthority
+	}
+
+	return nil
+}
+
+//Check validates the update against the chain state like the account_update evaluator: authorities
+//must not exceed the maximum authority membership of props and may reference existing accounts only.
+//It refuses updates that take away control from keys too. For the owner and active authority each,
+//keys satisfying it before the update have to satisfy it afterwards. To rotate to a new key, pass it
+//with keys or opt in with AllowRotation.
+func (p *AuthorityUpdate) Check(checker *AuthorityChecker, props *types.GlobalProperties, keys types.PublicKeys) error {
+	for _, role := range []struct {
+		name    string
+		current *types.Authority
+		updated *types.Authority
+	}{
+		{KeyRoleOwner, &p.account.Owner, p.owner},
+		{KeyRoleActive, &p.account.Active, p.active},
+	} {
+		if role.updated == nil {
+			continue
+		}
+
+		if err := checkAuthorityAccounts(checker, props, role.updated); err != nil {
+			return errors.Annotate(err, role.name)
+		}
+
+		before, err := checker.Satisfies(role.current, keys)
+		if err != nil {
+			return errors.Annotatef(err, "Satisfies [%s]", role.name)
+		}
+
+		held := keys
+		if p.rotation {
+			held = append(addedKeys(role.current, role.updated), keys...)
+		}
+
+		after, err := checker.Satisfies(role.updated, held)
+		if err != nil {
+			return errors.Annotatef(err, "Satisfies [%s]", role.name)
+		}
+
+		if before && !after {
+			return errors.Annotate(ErrAuthorityLockout, role.name)
+		}
+	}
+
+	return nil
+}
+
+//checkAuthorityAccounts mirrors graphene's verify_authority_accounts.
+func checkAuthorityAccounts(checker *AuthorityChecker, props *types.GlobalProperties, auth *types.Authority) error {
+	members := len(auth.AccountAuths) + len(auth.KeyAuths) + len(auth.AddressAuths)
+	if members > int(props.Parameters.MaximumAuthorityMembership) {
+		return errors.Annotatef(ErrAuthorityMembership, "%d > %d", members, props.Parameters.MaximumAuthorityMembership)
+	}
+
+	for _, ob := range sortedAccounts(auth.AccountAuths) {
+		acct, err := checker.account(ob.ID())
+		if err != nil {
+			return errors.Annotate(err, "account")
+		}
+
+		if acct == nil {
+			return errors.Annotate(ErrAccountNotFound, ob.ID())
+		}
+	}
+
+	return nil
+}
+
+//addedKeys returns the keys of updated not present in current.
+func addedKeys(current, updated *types.Authority) types.PublicKeys {
+	ret := types.PublicKeys{}
+	for pub := range updated.KeyAuths {
+		present := false
+		for key := range current.KeyAuths {
+			if key.Equal(pub) {
+				present = true
+				break
+			}
+		}
+
+		if !present {
+			ret = append(ret, *pub)
+		}
+	}
+
+	return ret
+}
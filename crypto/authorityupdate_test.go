@@ -0,0 +1,112 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/denkhaus/bitshares/config"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthorityUpdate(t *testing.T) {
+	config.SetCurrent(config.ChainIDGPH)
+
+	keys := make([]*types.PrivateKey, 4)
+	for idx := range keys {
+		key, err := types.GeneratePrivateKey()
+		if !assert.NoError(t, err) {
+			return
+		}
+		keys[idx] = key
+	}
+
+	account := types.Account{
+		ID:     accountID("1.2.100"),
+		Owner:  keyAuthority(1, keys[0]),
+		Active: keyAuthority(1, keys[1]),
+	}
+	account.Options.MemoKey = *keys[1].PublicKey()
+
+	checker := NewAuthorityChecker(AccountsLookup(types.Accounts{
+		account,
+		{ID: accountID("1.2.200"), Active: keyAuthority(1, keys[3]), Owner: keyAuthority(1, keys[3])},
+	}))
+
+	_, err := NewAuthorityUpdate(&account).Operation()
+	assert.Equal(t, ErrNoAuthorityChanges, err)
+
+	_, err = NewAuthorityUpdate(&account).AddKey(KeyRoleMemo, *keys[2].PublicKey(), 1).Operation()
+	assert.Equal(t, ErrInvalidAuthorityRole, errors.Cause(err))
+
+	// 2 of 3 multisig for the active authority, the memo key is rotated
+	update := NewAuthorityUpdate(&account).
+		AddKey(KeyRoleActive, *keys[2].PublicKey(), 1).
+		AddAccount(KeyRoleActive, types.NewAccountID("1.2.200"), 1).
+		SetThreshold(KeyRoleActive, 2).
+		SetMemoKey(*keys[2].PublicKey())
+
+	op, err := update.Operation()
+	if assert.NoError(t, err) {
+		assert.Nil(t, op.Owner)
+		if assert.NotNil(t, op.Active) {
+			assert.Len(t, op.Active.KeyAuths, 2)
+			assert.Len(t, op.Active.AccountAuths, 1)
+			assert.Equal(t, types.UInt32(2), op.Active.WeightThreshold)
+		}
+		if assert.NotNil(t, op.NewOptions) {
+			assert.True(t, op.NewOptions.MemoKey.Equal(keys[2].PublicKey()))
+		}
+	}
+
+	// the account is left untouched
+	assert.Len(t, account.Active.KeyAuths, 1)
+	assert.True(t, account.Options.MemoKey.Equal(keys[1].PublicKey()))
+
+	props := types.GlobalProperties{}
+	props.Parameters.MaximumAuthorityMembership = 10
+
+	// keys added by the update count as held only if passed or rotations are allowed
+	assert.Equal(t, ErrAuthorityLockout, errors.Cause(update.Check(checker, &props, publics(keys[1]))))
+	assert.NoError(t, update.Check(checker, &props, publics(keys[1], keys[2])))
+
+	// the active key alone loses control to the account
+	multisig := NewAuthorityUpdate(&account).
+		AddAccount(KeyRoleActive, types.NewAccountID("1.2.200"), 1).
+		SetThreshold(KeyRoleActive, 2)
+
+	assert.Equal(t, ErrAuthorityLockout, errors.Cause(multisig.Check(checker, &props, publics(keys[1]))))
+	assert.NoError(t, multisig.Check(checker, &props, publics(keys[1], keys[3])))
+
+	// authorities are limited by the chain parameters and reference existing accounts only
+	props.Parameters.MaximumAuthorityMembership = 2
+	assert.Equal(t, ErrAuthorityMembership, errors.Cause(update.Check(checker, &props, publics(keys[1]))))
+	props.Parameters.MaximumAuthorityMembership = 10
+
+	unknown := NewAuthorityUpdate(&account).AddAccount(KeyRoleActive, types.NewAccountID("1.2.300"), 1)
+	assert.Equal(t, ErrAccountNotFound, errors.Cause(unknown.Check(checker, &props, publics(keys[1]))))
+
+	// thresholds beyond the total weight are impossible
+	_, err = NewAuthorityUpdate(&account).SetThreshold(KeyRoleOwner, 2).Operation()
+	assert.Equal(t, ErrImpossibleAuthority, errors.Cause(err))
+
+	_, err = NewAuthorityUpdate(&account).RemoveKey(KeyRoleOwner, *keys[0].PublicKey()).Operation()
+	assert.Equal(t, ErrImpossibleAuthority, errors.Cause(err))
+
+	// replacing the owner key locks out the old key unless the new one is held
+	rotate := NewAuthorityUpdate(&account).
+		AddKey(KeyRoleOwner, *keys[2].PublicKey(), 1).
+		RemoveKey(KeyRoleOwner, *keys[0].PublicKey())
+
+	assert.Equal(t, ErrAuthorityLockout, errors.Cause(rotate.Check(checker, &props, publics(keys[0]))))
+	assert.NoError(t, rotate.Check(checker, &props, publics(keys[0], keys[2])))
+	assert.NoError(t, rotate.AllowRotation().Check(checker, &props, publics(keys[0])))
+
+	// raising the threshold beyond the held keys locks them out
+	raise := NewAuthorityUpdate(&account).
+		AddAccount(KeyRoleOwner, types.NewAccountID("1.2.200"), 1).
+		SetThreshold(KeyRoleOwner, 2)
+
+	assert.Equal(t, ErrAuthorityLockout, errors.Cause(raise.Check(checker, &props, publics(keys[0]))))
+	assert.NoError(t, raise.Check(checker, &props, publics(keys[0], keys[3])))
+}
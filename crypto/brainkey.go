@@ -0,0 +1,210 @@
+package crypto
+
+//go:generate go run ../gen/brainkeywords -out gen_brainkeywords.go
+
+import (
+	"bufio"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"io"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
+)
+
+const (
+	//BrainKeyWordCount is the number of words of a suggested brain key.
+	BrainKeyWordCount = 16
+)
+
+var (
+	ErrBrainKeyDictionaryMissing = errors.New("brain key dictionary not loaded")
+	ErrInvalidBrainKey           = errors.New("invalid brain key")
+)
+
+var (
+	dictionaryMu sync.RWMutex
+	dictionary   []string
+)
+
+//BrainKeyInfo holds a brain key and the key derived from it, like cli_wallet's brain_key_info.
+type BrainKeyInfo struct {
+	BrainPrivKey string            `json:"brain_priv_key"`
+	WifPrivKey   string            `json:"wif_priv_key"`
+	PubKey       types.PublicKey   `json:"pub_key"`
+	PrivateKey   *types.PrivateKey `json:"-"`
+}
+
+func newBrainKeyInfo(brainKey string, priv *types.PrivateKey) *BrainKeyInfo {
+	return &BrainKeyInfo{
+		BrainPrivKey: brainKey,
+		WifPrivKey:   priv.ToWIF(),
+		PubKey:       *priv.PublicKey(),
+		PrivateKey:   priv,
+	}
+}
+
+//SetBrainKeyDictionary overrides the word list SuggestBrainKey picks words from.
+//A nil list restores graphene's standard dictionary, which keeps brain keys compatible with cli_wallet
+//and the reference UI.
+func SetBrainKeyDictionary(words []string) {
+	dictionaryMu.Lock()
+	defer dictionaryMu.Unlock()
+	dictionary = words
+}
+
+//LoadBrainKeyDictionary reads a comma or whitespace separated word list.
+//Quotes and brackets around words are removed, so a JSON string array is accepted too.
+func LoadBrainKeyDictionary(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	scanner.Split(bufio.ScanWords)
+
+	var words []string
+	for scanner.Scan() {
+		for _, word := range strings.Split(scanner.Text(), ",") {
+			word = strings.Trim(word, "\"'[]")
+			if word != "" {
+				words = append(words, word)
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return errors.Annotate(err, "Scan")
+	}
+
+	if len(words) == 0 {
+		return ErrBrainKeyDictionaryMissing
+	}
+
+	SetBrainKeyDictionary(words)
+	return nil
+}
+
+//LoadBrainKeyDictionaryFromFile reads the brain key word list from path.
+func LoadBrainKeyDictionaryFromFile(path string) error {
+	inFile, err := os.Open(path)
+	if err != nil {
+		return errors.Errorf("load brain key dictionary [%s], %s", path, err)
+	}
+	defer inFile.Close()
+
+	return LoadBrainKeyDictionary(inFile)
+}
+
+//SuggestBrainKey suggests a random brain key of BrainKeyWordCount words and its first derived key,
+//like cli_wallet's suggest_brain_key. Words are picked from graphene's standard dictionary
+//unless overridden by SetBrainKeyDictionary.
+func SuggestBrainKey() (*BrainKeyInfo, error) {
+	dictionaryMu.RLock()
+	words := dictionary
+	dictionaryMu.RUnlock()
+
+	if len(words) == 0 {
+		words = brainKeyWords
+	}
+
+	if len(words) == 0 {
+		return nil, ErrBrainKeyDictionaryMissing
+	}
+
+	// two 256 bit secrets provide the entropy of the word choice
+	seed := make([]byte, 64)
+	if _, err := io.ReadFull(rand.Reader, seed); err != nil {
+		return nil, errors.Annotate(err, "ReadFull")
+	}
+
+	entropy := new(big.Int).SetBytes(seed)
+	size := big.NewInt(int64(len(words)))
+	choice := new(big.Int)
+
+	picked := make([]string, 0, BrainKeyWordCount)
+	for i := 0; i < BrainKeyWordCount; i++ {
+		entropy.QuoRem(entropy, size, choice)
+		picked = append(picked, words[choice.Int64()])
+	}
+
+	brainKey := NormalizeBrainKey(strings.Join(picked, " "))
+	priv, err := DerivePrivateKey(brainKey, 0)
+	if err != nil {
+		return nil, errors.Annotate(err, "DerivePrivateKey")
+	}
+
+	return newBrainKeyInfo(brainKey, priv), nil
+}
+
+//NormalizeBrainKey uppercases brainKey and collapses whitespace into single spaces.
+//Like graphene, only ASCII letters and whitespace are considered.
+func NormalizeBrainKey(brainKey string) string {
+	var sb strings.Builder
+	sb.Grow(len(brainKey))
+
+	whitespace := false
+	for i := 0; i < len(brainKey); i++ {
+		c := brainKey[i]
+		switch c {
+		case ' ', '\t', '\r', '\n', '\v', '\f':
+			whitespace = true
+			continue
+		}
+
+		if c >= 'a' && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+
+		if whitespace && sb.Len() > 0 {
+			sb.WriteByte(' ')
+		}
+
+		sb.WriteByte(c)
+		whitespace = false
+	}
+
+	return sb.String()
+}
+
+//DerivePrivateKey derives the private key number sequence from prefix,
+//which is sha256(sha512(prefix + " " + sequence)).
+func DerivePrivateKey(prefix string, sequence int) (*types.PrivateKey, error) {
+	h := sha512.Sum512([]byte(prefix + " " + strconv.Itoa(sequence)))
+	secret := sha256.Sum256(h[:])
+
+	priv, err := types.NewPrivateKeyFromSecret(secret[:])
+	if err != nil {
+		return nil, errors.Annotate(err, "NewPrivateKeyFromSecret")
+	}
+
+	return priv, nil
+}
+
+//DeriveOwnerKeysFromBrainKey derives the first count keys of brainKey,
+//like cli_wallet's derive_owner_keys_from_brain_key.
+func DeriveOwnerKeysFromBrainKey(brainKey string, count int) ([]BrainKeyInfo, error) {
+	if count < 1 {
+		return nil, errors.Errorf("invalid number of keys %d", count)
+	}
+
+	brainKey = NormalizeBrainKey(brainKey)
+	if brainKey == "" {
+		return nil, ErrInvalidBrainKey
+	}
+
+	ret := make([]BrainKeyInfo, 0, count)
+	for i := 0; i < count; i++ {
+		priv, err := DerivePrivateKey(brainKey, i)
+		if err != nil {
+			return nil, errors.Annotatef(err, "DerivePrivateKey [%d]", i)
+		}
+
+		ret = append(ret, *newBrainKeyInfo(brainKey, priv))
+	}
+
+	return ret, nil
+}
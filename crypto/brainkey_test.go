@@ -0,0 +1,151 @@
+package crypto
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/denkhaus/bitshares/config"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/stretchr/testify/assert"
+)
+
+//reference vectors of python-graphenelib, which derives keys like cli_wallet
+const testBrainKey = "COLORER BICORN KASBEKE FAERIE LOCHIA GOMUTI SOVKHOZ Y GERMAL AUNTIE PERFUMY TIME FEATURE GANGAN CELEMIN MATZO"
+
+var testBrainKeyWIFs = []string{
+	1: "5Hsbn6kXio4bb7eW5bX7kTp2sdkmbzP8kGWoau46Cf7en7T1RRE",
+	2: "5K9MHEyiSye5iFL2srZu3ZVjzAZjcQxUgUvuttcVrymovFbU4cc",
+	3: "5JBXhzDWQdYPAzRxxuGtzqM7ULLKPK7GZmktHTyF9foGGfbtDLT",
+	4: "5Kbbfbs6DmJFNddWiP1XZfDKwhm5dkn9KX5AENQfQke2RYBBDcz",
+	5: "5JUqLwgxn8f7myNz4gDwo5e77HZgopHMDHv4icNVww9Rxu1GDG5",
+}
+
+func TestNormalizeBrainKey(t *testing.T) {
+	for _, variant := range []string{
+		testBrainKey,
+		testBrainKey + " ",
+		testBrainKey + "  ",
+		testBrainKey + "\t",
+		testBrainKey + "\t\t",
+		strings.Replace(testBrainKey, " ", "\t", -1),
+		strings.Replace(testBrainKey, " ", "  ", -1),
+		strings.ToLower(testBrainKey),
+	} {
+		assert.Equal(t, testBrainKey, NormalizeBrainKey(variant), variant)
+	}
+
+	assert.Equal(t, "", NormalizeBrainKey(" \t "))
+	assert.Equal(t, "äLPHA", NormalizeBrainKey("älpha"), "only ascii letters are uppercased")
+}
+
+func TestDerivePrivateKey(t *testing.T) {
+	config.SetCurrent(config.ChainIDBTS)
+
+	for seq, wif := range testBrainKeyWIFs {
+		if wif == "" {
+			continue
+		}
+
+		priv, err := DerivePrivateKey(testBrainKey, seq)
+		if assert.NoError(t, err) {
+			assert.Equal(t, wif, priv.ToWIF(), "sequence %d", seq)
+		}
+	}
+}
+
+func TestDeriveOwnerKeysFromBrainKey(t *testing.T) {
+	config.SetCurrent(config.ChainIDBTS)
+
+	infos, err := DeriveOwnerKeysFromBrainKey(strings.ToLower(strings.Replace(testBrainKey, " ", " \t", -1)), len(testBrainKeyWIFs))
+	if !assert.NoError(t, err) || !assert.Len(t, infos, len(testBrainKeyWIFs)) {
+		return
+	}
+
+	for seq, info := range infos {
+		assert.Equal(t, testBrainKey, info.BrainPrivKey)
+		if wif := testBrainKeyWIFs[seq]; wif != "" {
+			assert.Equal(t, wif, info.WifPrivKey, "sequence %d", seq)
+		}
+
+		key, err := types.NewPrivateKeyFromWif(info.WifPrivKey)
+		if assert.NoError(t, err) {
+			assert.True(t, key.PublicKey().Equal(&info.PubKey))
+		}
+	}
+
+	_, err = DeriveOwnerKeysFromBrainKey(" ", 1)
+	assert.Equal(t, ErrInvalidBrainKey, err)
+	_, err = DeriveOwnerKeysFromBrainKey("alpha", 0)
+	assert.Error(t, err)
+}
+
+func TestSuggestBrainKey(t *testing.T) {
+	config.SetCurrent(config.ChainIDGPH)
+
+	SetBrainKeyDictionary(nil)
+	if len(brainKeyWords) == 0 {
+		_, err := SuggestBrainKey()
+		assert.Equal(t, ErrBrainKeyDictionaryMissing, err)
+	}
+
+	err := LoadBrainKeyDictionary(strings.NewReader(`["alpha","beta", "gamma",
+		"delta"]`))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	info, err := SuggestBrainKey()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	words := strings.Split(info.BrainPrivKey, " ")
+	assert.Len(t, words, BrainKeyWordCount)
+	for _, word := range words {
+		assert.Contains(t, []string{"ALPHA", "BETA", "GAMMA", "DELTA"}, word)
+	}
+
+	infos, err := DeriveOwnerKeysFromBrainKey(strings.ToLower(info.BrainPrivKey), 1)
+	if assert.NoError(t, err) {
+		assert.Equal(t, info.WifPrivKey, infos[0].WifPrivKey)
+	}
+}
+
+func TestSuggestBrainKeyStandardDictionary(t *testing.T) {
+	config.SetCurrent(config.ChainIDGPH)
+
+	if len(brainKeyWords) == 0 {
+		t.Skip("standard dictionary not generated, run go generate in the crypto package")
+	}
+
+	assert.Len(t, brainKeyWords, 49744)
+
+	SetBrainKeyDictionary(nil)
+	info, err := SuggestBrainKey()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	words := strings.Split(info.BrainPrivKey, " ")
+	if assert.Len(t, words, BrainKeyWordCount) {
+		assert.Contains(t, brainKeyWords, strings.ToLower(words[0]))
+	}
+}
+
+func TestGeneratePrivateKey(t *testing.T) {
+	config.SetCurrent(config.ChainIDGPH)
+
+	priv, err := types.GeneratePrivateKey()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	key, err := types.NewPrivateKeyFromWif(priv.ToWIF())
+	if assert.NoError(t, err) {
+		assert.Equal(t, priv.ToHex(), key.ToHex())
+		assert.True(t, priv.PublicKey().Equal(key.PublicKey()))
+	}
+
+	_, err = types.NewPrivateKeyFromSecret(make([]byte, 32))
+	assert.Equal(t, types.ErrInvalidPrivateKeySecret, err)
+}
@@ -0,0 +1,129 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
+)
+
+const (
+	maxCanonicalAttempts = 256
+	//maxSignAttempts bounds the expiration adjustments SignWith makes to get canonical signatures.
+	maxSignAttempts = 128
+)
+
+var (
+	ErrNonCanonicalSignature = errors.New("signature is not canonical")
+)
+
+//signCanonical signs digest with priv. Like fc, a non canonical signature is retried
+//with an RFC6979 nonce extended by a counter, so the signed data doesn't have to change.
+func signCanonical(priv *types.PrivateKey, digest []byte) ([]byte, error) {
+	sig, err := priv.SignCompact(digest)
+	if err != nil {
+		return nil, errors.Annotate(err, "SignCompact")
+	}
+
+	for counter := uint32(1); !isCanonical(sig); counter++ {
+		if counter > maxCanonicalAttempts {
+			return nil, ErrNonCanonicalSignature
+		}
+
+		sig, err = signCompactWithCounter(priv, digest, counter)
+		if err != nil {
+			return nil, errors.Annotate(err, "signCompactWithCounter")
+		}
+	}
+
+	return sig, nil
+}
+
+func signCompactWithCounter(priv *types.PrivateKey, digest []byte, counter uint32) ([]byte, error) {
+	curve := btcec.S256()
+	key := priv.ToECDSA()
+	n := curve.N
+
+	extra := make([]byte, 32)
+	binary.LittleEndian.PutUint32(extra, counter)
+
+	k := nonceRFC6979(key.D, digest, extra)
+	r, _ := curve.ScalarBaseMult(k.Bytes())
+	r.Mod(r, n)
+	if r.Sign() == 0 {
+		return nil, errors.New("calculated R is zero")
+	}
+
+	e := new(big.Int).SetBytes(digest)
+	s := new(big.Int).Mul(key.D, r)
+	s.Add(s, e)
+	s.Mul(s, new(big.Int).ModInverse(k, n))
+	s.Mod(s, n)
+	if s.Cmp(new(big.Int).Rsh(n, 1)) > 0 {
+		s.Sub(n, s)
+	}
+	if s.Sign() == 0 {
+		return nil, errors.New("calculated S is zero")
+	}
+
+	sig := make([]byte, 65)
+	r.FillBytes(sig[1:33])
+	s.FillBytes(sig[33:65])
+
+	//find the recovery id of the key
+	pub := priv.PublicKey().Bytes()
+	for i := byte(0); i < 4; i++ {
+		sig[0] = 27 + 4 + i
+		rec, _, err := btcec.RecoverCompact(curve, sig, digest)
+		if err == nil && bytes.Equal(rec.SerializeCompressed(), pub) {
+			return sig, nil
+		}
+	}
+
+	return nil, errors.New("no valid solution for pubkey found")
+}
+
+//nonceRFC6979 generates a deterministic nonce according to RFC 6979 including additional data (section 3.6).
+func nonceRFC6979(priv *big.Int, digest, extra []byte) *big.Int {
+	n := btcec.S256().N
+	h := new(big.Int).SetBytes(digest)
+	h.Mod(h, n)
+
+	bx := make([]byte, 64, 64+len(extra))
+	priv.FillBytes(bx[:32])
+	h.FillBytes(bx[32:])
+	bx = append(bx, extra...)
+
+	v := bytes.Repeat([]byte{0x01}, 32)
+	k := make([]byte, 32)
+
+	k = hmacSHA256(k, v, []byte{0x00}, bx)
+	v = hmacSHA256(k, v)
+	k = hmacSHA256(k, v, []byte{0x01}, bx)
+	v = hmacSHA256(k, v)
+
+	for {
+		v = hmacSHA256(k, v)
+		secret := new(big.Int).SetBytes(v)
+		if secret.Sign() > 0 && secret.Cmp(n) < 0 {
+			return secret
+		}
+
+		k = hmacSHA256(k, v, []byte{0x00})
+		v = hmacSHA256(k, v)
+	}
+}
+
+func hmacSHA256(key []byte, data ...[]byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	for _, d := range data {
+		mac.Write(d)
+	}
+
+	return mac.Sum(nil)
+}
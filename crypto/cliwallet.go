@@ -0,0 +1,434 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/denkhaus/bitshares/config"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/denkhaus/bitshares/util"
+	"github.com/juju/errors"
+	"github.com/pquerna/ffjson/ffjson"
+)
+
+const (
+	CliWalletDefaultServer = "ws://localhost:8090"
+)
+
+var (
+	ErrCliWalletNoKeys = errors.New("wallet file has no cipher_keys")
+)
+
+//CliWalletExtraKeys maps account ids to additional public keys, like wallet_data::extra_keys.
+type CliWalletExtraKeys map[string]types.PublicKeys
+
+func (p *CliWalletExtraKeys) UnmarshalJSON(data []byte) error {
+	var pairs [][]json.RawMessage
+	if err := ffjson.Unmarshal(data, &pairs); err != nil {
+		return errors.Annotate(err, "Unmarshal [pairs]")
+	}
+
+	(*p) = make(CliWalletExtraKeys)
+	for _, pair := range pairs {
+		if len(pair) != 2 {
+			return types.ErrInvalidInputLength
+		}
+
+		var account string
+		if err := ffjson.Unmarshal(pair[0], &account); err != nil {
+			return errors.Annotate(err, "Unmarshal [account]")
+		}
+
+		var keys types.PublicKeys
+		if err := ffjson.Unmarshal(pair[1], &keys); err != nil {
+			return errors.Annotate(err, "Unmarshal [keys]")
+		}
+
+		(*p)[account] = keys
+	}
+
+	return nil
+}
+
+func (p CliWalletExtraKeys) MarshalJSON() ([]byte, error) {
+	accounts := make([]string, 0, len(p))
+	for account := range p {
+		accounts = append(accounts, account)
+	}
+	sort.Strings(accounts)
+
+	pairs := make([][]interface{}, 0, len(p))
+	for _, account := range accounts {
+		pairs = append(pairs, []interface{}{account, p[account]})
+	}
+
+	return ffjson.Marshal(pairs)
+}
+
+//CliWallet is the wallet file of cli_wallet, graphene's wallet_data.
+//Fields this package does not interpret are kept as they are.
+type CliWallet struct {
+	ChainID                     string             `json:"chain_id"`
+	MyAccounts                  []json.RawMessage  `json:"my_accounts"`
+	CipherKeys                  string             `json:"cipher_keys"`
+	ExtraKeys                   CliWalletExtraKeys `json:"extra_keys"`
+	PendingAccountRegistrations json.RawMessage    `json:"pending_account_registrations"`
+	PendingWitnessRegistrations json.RawMessage    `json:"pending_witness_registrations"`
+	LabeledKeys                 json.RawMessage    `json:"labeled_keys"`
+	BlindReceipts               json.RawMessage    `json:"blind_receipts"`
+	WsServer                    string             `json:"ws_server"`
+	WsUser                      string             `json:"ws_user"`
+	WsPassword                  string             `json:"ws_password"`
+}
+
+//NewCliWallet creates a wallet file for the current chain holding the keys of bag encrypted with password.
+func NewCliWallet(bag *KeyBag, password string) (*CliWallet, error) {
+	empty := json.RawMessage("[]")
+	wallet := CliWallet{
+		ChainID:                     config.Current().ID,
+		MyAccounts:                  []json.RawMessage{},
+		ExtraKeys:                   make(CliWalletExtraKeys),
+		PendingAccountRegistrations: empty,
+		PendingWitnessRegistrations: empty,
+		LabeledKeys:                 empty,
+		BlindReceipts:               empty,
+		WsServer:                    CliWalletDefaultServer,
+	}
+
+	if err := wallet.SetKeys(bag, password); err != nil {
+		return nil, errors.Annotate(err, "SetKeys")
+	}
+
+	return &wallet, nil
+}
+
+//ReadCliWallet reads a cli_wallet wallet file from r.
+func ReadCliWallet(r io.Reader) (*CliWallet, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Annotate(err, "ReadAll")
+	}
+
+	wallet := CliWallet{}
+	if err := ffjson.Unmarshal(data, &wallet); err != nil {
+		return nil, errors.Annotate(err, "Unmarshal [CliWallet]")
+	}
+
+	return &wallet, nil
+}
+
+//OpenCliWallet reads the cli_wallet wallet file at path.
+func OpenCliWallet(path string) (*CliWallet, error) {
+	inFile, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Errorf("open wallet file [%s], %s", path, err)
+	}
+	defer inFile.Close()
+
+	return ReadCliWallet(inFile)
+}
+
+//Unlock decrypts cipher_keys with password like cli_wallet's unlock and returns the keys.
+func (p CliWallet) Unlock(password string) (*KeyBag, error) {
+	if p.CipherKeys == "" {
+		return nil, ErrCliWalletNoKeys
+	}
+
+	data, err := hex.DecodeString(p.CipherKeys)
+	if err != nil {
+		return nil, errors.Annotate(err, "DecodeString [cipher_keys]")
+	}
+
+	checksum := sha512.Sum512([]byte(password))
+	plain, err := aesDecrypt(checksum[:], data)
+	if err != nil {
+		return nil, ErrInvalidPassword
+	}
+	defer zeroBytes(plain)
+
+	bag, err := unpackPlainKeys(plain, checksum[:])
+	if err != nil {
+		return nil, errors.Annotate(err, "unpackPlainKeys")
+	}
+
+	return bag, nil
+}
+
+//SetKeys replaces cipher_keys by the keys of bag encrypted with password.
+func (p *CliWallet) SetKeys(bag *KeyBag, password string) error {
+	if password == "" {
+		return errors.New("password required")
+	}
+
+	checksum := sha512.Sum512([]byte(password))
+	plain, err := packPlainKeys(bag, checksum[:])
+	if err != nil {
+		return errors.Annotate(err, "packPlainKeys")
+	}
+	defer zeroBytes(plain)
+
+	data, err := aesEncrypt(checksum[:], plain)
+	if err != nil {
+		return errors.Annotate(err, "aesEncrypt")
+	}
+
+	p.CipherKeys = hex.EncodeToString(data)
+	return nil
+}
+
+//Accounts decodes my_accounts.
+func (p CliWallet) Accounts() (types.Accounts, error) {
+	accounts := make(types.Accounts, 0, len(p.MyAccounts))
+	for _, raw := range p.MyAccounts {
+		var account types.Account
+		if err := ffjson.Unmarshal(raw, &account); err != nil {
+			return nil, errors.Annotate(err, "Unmarshal [Account]")
+		}
+
+		accounts = append(accounts, account)
+	}
+
+	return accounts, nil
+}
+
+//AddAccount adds account to my_accounts.
+func (p *CliWallet) AddAccount(account *types.Account) error {
+	raw, err := ffjson.Marshal(account)
+	if err != nil {
+		return errors.Annotate(err, "Marshal [Account]")
+	}
+
+	p.MyAccounts = append(p.MyAccounts, raw)
+	return nil
+}
+
+//AddExtraKey associates pub with account in extra_keys.
+func (p *CliWallet) AddExtraKey(account types.GrapheneObject, pub types.PublicKey) {
+	if p.ExtraKeys == nil {
+		p.ExtraKeys = make(CliWalletExtraKeys)
+	}
+
+	keys := p.ExtraKeys[account.ID()]
+	for _, key := range keys {
+		if key.Equal(&pub) {
+			return
+		}
+	}
+
+	p.ExtraKeys[account.ID()] = append(keys, pub)
+}
+
+//AccountKeys maps account ids to the public keys of bag their owner and active authorities,
+//memo key or extra_keys refer to.
+func (p CliWallet) AccountKeys(bag *KeyBag) (map[string]types.PublicKeys, error) {
+	accounts, err := p.Accounts()
+	if err != nil {
+		return nil, errors.Annotate(err, "Accounts")
+	}
+
+	ret := make(map[string]types.PublicKeys)
+	add := func(account string, pub *types.PublicKey) {
+		if !bag.Present(pub) {
+			return
+		}
+
+		for _, key := range ret[account] {
+			if key.Equal(pub) {
+				return
+			}
+		}
+
+		ret[account] = append(ret[account], *pub)
+	}
+
+	for _, account := range accounts {
+		id := account.ID.ID()
+		for key := range account.Owner.KeyAuths {
+			add(id, key)
+		}
+		for key := range account.Active.KeyAuths {
+			add(id, key)
+		}
+		add(id, &account.Options.MemoKey)
+	}
+
+	for account, keys := range p.ExtraKeys {
+		for idx := range keys {
+			add(account, &keys[idx])
+		}
+	}
+
+	return ret, nil
+}
+
+//ImportToKeyStore decrypts the keys with password and adds them to the unlocked ks,
+//associated with the accounts referring to them and labeled by account name.
+func (p CliWallet) ImportToKeyStore(ks *KeyStore, password string) error {
+	bag, err := p.Unlock(password)
+	if err != nil {
+		return errors.Annotate(err, "Unlock")
+	}
+	defer bag.zero()
+
+	accountKeys, err := p.AccountKeys(bag)
+	if err != nil {
+		return errors.Annotate(err, "AccountKeys")
+	}
+
+	accounts, err := p.Accounts()
+	if err != nil {
+		return errors.Annotate(err, "Accounts")
+	}
+
+	names := make(map[string]string)
+	for _, account := range accounts {
+		names[account.ID.ID()] = account.Name.String()
+	}
+
+	for _, key := range bag.keys {
+		if err := ks.AddKey(key, ""); err != nil && err != ErrKeyAlreadyPresent {
+			return errors.Annotate(err, "AddKey")
+		}
+	}
+
+	for account, keys := range accountKeys {
+		for idx := range keys {
+			if err := ks.Associate(&keys[idx], types.NewAccountID(account)); err != nil {
+				return errors.Annotate(err, "Associate")
+			}
+
+			if name, ok := names[account]; ok {
+				if err := ks.SetLabel(&keys[idx], name); err != nil {
+					return errors.Annotate(err, "SetLabel")
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+//WriteTo writes the wallet file to w.
+func (p CliWallet) WriteTo(w io.Writer) (int64, error) {
+	data, err := ffjson.Marshal(p)
+	if err != nil {
+		return 0, errors.Annotate(err, "Marshal [CliWallet]")
+	}
+
+	n, err := w.Write(data)
+	return int64(n), err
+}
+
+//Save writes the wallet file to path, readable by the owner only.
+func (p CliWallet) Save(path string) error {
+	outFile, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return errors.Errorf("save wallet file [%s], %s", path, err)
+	}
+
+	if _, err := p.WriteTo(outFile); err != nil {
+		outFile.Close()
+		return errors.Annotate(err, "WriteTo")
+	}
+
+	return outFile.Close()
+}
+
+//packPlainKeys serializes graphene's plain_keys, a map of public keys to WIF keys followed by the checksum.
+func packPlainKeys(bag *KeyBag, checksum []byte) ([]byte, error) {
+	keys := make([]*types.PrivateKey, len(bag.keys))
+	copy(keys, bag.keys)
+
+	// fc::flat_map orders by the serialized public key
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i].PublicKey().Bytes(), keys[j].PublicKey().Bytes()) < 0
+	})
+
+	var buf bytes.Buffer
+	enc := util.NewTypeEncoder(&buf)
+	if err := enc.EncodeUVarint(uint64(len(keys))); err != nil {
+		return nil, errors.Annotate(err, "encode length")
+	}
+
+	for _, key := range keys {
+		if err := enc.Encode(key.PublicKey()); err != nil {
+			return nil, errors.Annotate(err, "encode PublicKey")
+		}
+
+		if err := enc.EncodeString(key.ToWIF()); err != nil {
+			return nil, errors.Annotate(err, "encode WIF")
+		}
+	}
+
+	if err := enc.Encode(checksum); err != nil {
+		return nil, errors.Annotate(err, "encode checksum")
+	}
+
+	return buf.Bytes(), nil
+}
+
+func unpackPlainKeys(plain []byte, checksum []byte) (*KeyBag, error) {
+	r := bytes.NewReader(plain)
+	count, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, ErrInvalidPassword
+	}
+
+	// a wrong password is detected by the checksum, but the length has to be sane to get there
+	if count > uint64(r.Len()) {
+		return nil, ErrInvalidPassword
+	}
+
+	bag := NewKeyBag()
+	unpacked := false
+	defer func() {
+		if !unpacked {
+			bag.zero()
+		}
+	}()
+
+	for i := uint64(0); i < count; i++ {
+		pubData := make([]byte, btcec.PubKeyBytesLenCompressed)
+		if _, err := io.ReadFull(r, pubData); err != nil {
+			return nil, ErrInvalidPassword
+		}
+
+		length, err := binary.ReadUvarint(r)
+		if err != nil || length > uint64(r.Len()) {
+			return nil, ErrInvalidPassword
+		}
+
+		wif := make([]byte, length)
+		if _, err := io.ReadFull(r, wif); err != nil {
+			return nil, ErrInvalidPassword
+		}
+
+		key, err := types.NewPrivateKeyFromWif(string(wif))
+		zeroBytes(wif)
+		if err != nil {
+			return nil, ErrInvalidPassword
+		}
+
+		if !bytes.Equal(key.PublicKey().Bytes(), pubData) {
+			return nil, errors.Errorf("private key does not match public key %x", pubData)
+		}
+
+		bag.keys = append(bag.keys, key)
+	}
+
+	chk := make([]byte, sha512.Size)
+	if _, err := io.ReadFull(r, chk); err != nil || !bytes.Equal(chk, checksum) {
+		return nil, ErrInvalidPassword
+	}
+
+	unpacked = true
+	return bag, nil
+}
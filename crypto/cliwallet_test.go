@@ -0,0 +1,177 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/hex"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/denkhaus/bitshares/config"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/stretchr/testify/assert"
+)
+
+//testdata/cliwallet.json was written following graphene's wallet.cpp, cipher_keys being
+//aes_encrypt(sha512(password), pack(plain_keys)), password "fixture password". The wallet of
+//alice (1.2.100) holds the keys of BTS6UUbAGbTLLWfY2gAc8XmjGBz2c7WT4fYB5r1L1aHDwAY88ujex (owner and active)
+//and BTS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV (memo).
+const (
+	cliWalletPassword = "fixture password"
+	cliWalletOwnerWIF = "5HueCGU8rMjxEXxiPuD5BDku4MkFqeZyd4dZ1jvhTVqvbTLvyTJ"
+	cliWalletMemoWIF  = "5KQwrPbwdL6PhXujxW37FSSQZ1JiwsST4cqQzDeyXtP79zkvFD3"
+)
+
+func TestCliWalletFixture(t *testing.T) {
+	config.SetCurrent(config.ChainIDBTS)
+
+	wallet, err := OpenCliWallet(filepath.Join("testdata", "cliwallet.json"))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, config.ChainIDBTS, wallet.ChainID)
+
+	_, err = wallet.Unlock("wrong")
+	assert.Equal(t, ErrInvalidPassword, err)
+
+	bag, err := wallet.Unlock(cliWalletPassword)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	owner, err := types.NewPublicKeyFromString("BTS6UUbAGbTLLWfY2gAc8XmjGBz2c7WT4fYB5r1L1aHDwAY88ujex")
+	if !assert.NoError(t, err) {
+		return
+	}
+	memo, err := types.NewPublicKeyFromString("BTS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Len(t, bag.Publics(), 2)
+	if assert.NotNil(t, bag.Private(owner)) && assert.NotNil(t, bag.Private(memo)) {
+		assert.Equal(t, cliWalletOwnerWIF, bag.Private(owner).ToWIF())
+		assert.Equal(t, cliWalletMemoWIF, bag.Private(memo).ToWIF())
+	}
+
+	accounts, err := wallet.Accounts()
+	if assert.NoError(t, err) && assert.Len(t, accounts, 1) {
+		assert.Equal(t, "alice", accounts[0].Name.String())
+	}
+
+	accountKeys, err := wallet.AccountKeys(bag)
+	if assert.NoError(t, err) {
+		assert.Len(t, accountKeys["1.2.100"], 2)
+	}
+
+	// the packing is deterministic, re-encrypting the keys gives the same cipher_keys
+	cipherKeys := wallet.CipherKeys
+	if assert.NoError(t, wallet.SetKeys(bag, cliWalletPassword)) {
+		assert.Equal(t, cipherKeys, wallet.CipherKeys)
+	}
+
+	ks := newTestKeyStore()
+	if assert.NoError(t, ks.SetPassword("store")) {
+		assert.NoError(t, wallet.ImportToKeyStore(ks, cliWalletPassword))
+		for _, entry := range ks.Entries() {
+			assert.Equal(t, "alice", entry.Label)
+		}
+		assert.Len(t, ks.PublicsByAccount(types.NewAccountID("1.2.100")), 2)
+	}
+}
+
+func TestCliWalletRoundTrip(t *testing.T) {
+	config.SetCurrent(config.ChainIDGPH)
+
+	bag := NewKeyBag()
+	for i := 0; i < 3; i++ {
+		priv, err := types.GeneratePrivateKey()
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.NoError(t, bag.Add(priv.ToWIF()))
+	}
+
+	wallet, err := NewCliWallet(bag, "secret")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	pubs := bag.Publics()
+	wallet.AddExtraKey(types.NewAccountID("1.2.100"), pubs[0])
+	wallet.AddExtraKey(types.NewAccountID("1.2.100"), pubs[0])
+	wallet.AddExtraKey(types.NewAccountID("1.2.200"), pubs[1])
+
+	var buf bytes.Buffer
+	if _, err := wallet.WriteTo(&buf); !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Contains(t, buf.String(), `"extra_keys":[["1.2.100",["`+pubs[0].String()+`"]]`)
+	assert.NotContains(t, buf.String(), bag.keys[0].ToWIF())
+
+	loaded, err := ReadCliWallet(strings.NewReader(buf.String()))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	_, err = loaded.Unlock("wrong")
+	assert.Equal(t, ErrInvalidPassword, err)
+
+	unlocked, err := loaded.Unlock("secret")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Len(t, unlocked.Publics(), 3)
+	for _, pub := range pubs {
+		assert.Equal(t, bag.Private(&pub).ToWIF(), unlocked.Private(&pub).ToWIF())
+	}
+
+	accountKeys, err := loaded.AccountKeys(unlocked)
+	if assert.NoError(t, err) {
+		assert.Len(t, accountKeys["1.2.100"], 1)
+		assert.Len(t, accountKeys["1.2.200"], 1)
+	}
+
+	ks := newTestKeyStore()
+	if assert.NoError(t, ks.SetPassword("store")) {
+		assert.NoError(t, loaded.ImportToKeyStore(ks, "secret"))
+		assert.Len(t, ks.Entries(), 3)
+		assert.Len(t, ks.PublicsByAccount(types.NewAccountID("1.2.200")), 1)
+	}
+}
+
+func TestCliWalletPlainKeys(t *testing.T) {
+	config.SetCurrent(config.ChainIDGPH)
+
+	priv, err := types.GeneratePrivateKey()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	bag := NewKeyBag()
+	assert.NoError(t, bag.Add(priv.ToWIF()))
+
+	wallet, err := NewCliWallet(bag, "secret")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// fc::raw::pack(plain_keys) encrypted with sha512(password)
+	checksum := sha512.Sum512([]byte("secret"))
+	data, _ := hex.DecodeString(wallet.CipherKeys)
+	plain, err := aesDecrypt(checksum[:], data)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	wif := priv.ToWIF()
+	assert.Equal(t, byte(1), plain[0])
+	assert.Equal(t, priv.PublicKey().Bytes(), plain[1:34])
+	assert.Equal(t, byte(len(wif)), plain[34])
+	assert.Equal(t, wif, string(plain[35:35+len(wif)]))
+	assert.Equal(t, checksum[:], plain[35+len(wif):])
+}
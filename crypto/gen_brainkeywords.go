@@ -0,0 +1,5 @@
+package crypto
+
+//brainKeyWords is graphene's standard brain key dictionary. This placeholder is replaced by
+//running go generate in the crypto package, which fetches the 49744 words from bitshares-core.
+var brainKeyWords []string
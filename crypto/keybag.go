@@ -12,7 +12,8 @@ import (
 
 // KeyBag is a PrivateKey collection for signing and verifying purposes.
 type KeyBag struct {
-	keys []*types.PrivateKey
+	keys     []*types.PrivateKey
+	hardened bool
 }
 
 func NewKeyBag() *KeyBag {
@@ -23,6 +24,33 @@ func NewKeyBag() *KeyBag {
 	return &bag
 }
 
+//NewHardenedKeyBag returns a KeyBag that keeps its keys in locked memory,
+//never hands out copies of them and wipes them on Remove and Close.
+func NewHardenedKeyBag() *KeyBag {
+	bag := NewKeyBag()
+	bag.hardened = true
+	return bag
+}
+
+//Hardened returns true if the bag was created by NewHardenedKeyBag.
+func (b KeyBag) Hardened() bool {
+	return b.hardened
+}
+
+//hold prepares priv to be held by the bag, locking it in hardened mode.
+func (b *KeyBag) hold(priv *types.PrivateKey) error {
+	if !b.hardened {
+		return nil
+	}
+
+	if err := priv.Lock(); err != nil {
+		priv.Zero()
+		return errors.Annotate(err, "Lock")
+	}
+
+	return nil
+}
+
 func (p KeyBag) Marshal(enc *util.TypeEncoder) error {
 	if err := enc.EncodeUVarint(uint64(len(p.keys))); err != nil {
 		return errors.Annotate(err, "encode length")
@@ -49,6 +77,10 @@ func (p *KeyBag) Unmarshal(dec *util.TypeDecoder) error {
 			return errors.Annotate(err, "decode key")
 		}
 
+		if err := p.hold(&key); err != nil {
+			return errors.Annotate(err, "hold")
+		}
+
 		p.keys = append(p.keys, &key)
 	}
 
@@ -61,15 +93,23 @@ func (b *KeyBag) Add(wifKey string) error {
 		return errors.Annotate(err, "NewPrivateKeyFromWif")
 	}
 
+	if err := b.hold(privKey); err != nil {
+		return errors.Annotate(err, "hold")
+	}
+
 	b.keys = append(b.keys, privKey)
 	return nil
 }
 
+//Remove removes the key of pub, in hardened mode the key is wiped.
 func (b *KeyBag) Remove(pub string) bool {
 	for _, p := range b.Publics() {
 		if p.String() == pub {
 			for idx, k := range b.keys {
 				if k.PublicKey().Equal(&p) {
+					if b.hardened {
+						k.Zero()
+					}
 					b.keys = append(b.keys[:idx], b.keys[idx+1:]...)
 					return true
 				}
@@ -81,7 +121,7 @@ func (b *KeyBag) Remove(pub string) bool {
 }
 
 func (b KeyBag) EncryptMemo(memo *types.Memo, msg string) error {
-	priv := b.Private(&memo.From)
+	priv := b.private(&memo.From)
 	if priv == nil {
 		return errors.Errorf(
 			"private key related to %q not found in KeyBag",
@@ -96,6 +136,11 @@ func (b KeyBag) EncryptMemo(memo *types.Memo, msg string) error {
 	return nil
 }
 
+//DecryptMemo decrypts memo with the private key of either side of the memo.
+func (b KeyBag) DecryptMemo(memo *types.Memo) (string, error) {
+	return DecryptMemo(b, memo)
+}
+
 func (b *KeyBag) ImportFromFile(path string) error {
 	inFile, err := os.Open(path)
 	if err != nil {
@@ -131,7 +176,12 @@ func (b KeyBag) Publics() (out types.PublicKeys) {
 }
 
 // Privates returns a collection of private keys in bag.
+// A hardened bag returns nil.
 func (b KeyBag) Privates() (out types.PrivateKeys) {
+	if b.hardened {
+		return nil
+	}
+
 	for _, k := range b.keys {
 		priv := k
 		out = append(out, *priv)
@@ -141,21 +191,60 @@ func (b KeyBag) Privates() (out types.PrivateKeys) {
 
 // Present checks if a private key associated with the given public key is present
 func (b KeyBag) Present(pub *types.PublicKey) bool {
-	return b.Private(pub) != nil
+	return b.private(pub) != nil
 }
 
-//Private returns the private key associated with the given public key
+//Private returns a copy of the private key associated with the given public key.
+//A hardened bag never returns its keys.
 func (b KeyBag) Private(pub *types.PublicKey) *types.PrivateKey {
+	if b.hardened {
+		return nil
+	}
+
+	if k := b.private(pub); k != nil {
+		priv := *k
+		return &priv
+	}
+	return nil
+}
+
+//private returns the key held by the bag, not a copy.
+func (b KeyBag) private(pub *types.PublicKey) *types.PrivateKey {
 	for _, k := range b.keys {
 		if k.PublicKey().Equal(pub) {
-			priv := *k
-			return &priv
+			return k
 		}
 	}
 	return nil
 }
 
+//SignCompact signs digest with the private key of pub. The signature is always canonical.
+func (b KeyBag) SignCompact(pub *types.PublicKey, digest []byte) ([]byte, error) {
+	priv := b.private(pub)
+	if priv == nil {
+		return nil, ErrKeyNotFound
+	}
+
+	return signCanonical(priv, digest)
+}
+
+//SharedSecret returns the shared secret of the private key of pub and counterparty.
+func (b KeyBag) SharedSecret(pub, counterparty *types.PublicKey, skLen, macLen int) ([]byte, error) {
+	priv := b.private(pub)
+	if priv == nil {
+		return nil, ErrKeyNotFound
+	}
+
+	return priv.SharedSecret(counterparty, skLen, macLen)
+}
+
+//PrivatesByPublics returns the private keys of pubKeys in bag.
+//A hardened bag returns nil.
 func (b KeyBag) PrivatesByPublics(pubKeys types.PublicKeys) (out types.PrivateKeys) {
+	if b.hardened {
+		return nil
+	}
+
 	for _, pub := range pubKeys {
 		for _, k := range b.keys {
 			if pub.Equal(k.PublicKey()) {
@@ -167,3 +256,30 @@ func (b KeyBag) PrivatesByPublics(pubKeys types.PublicKeys) (out types.PrivateKe
 
 	return
 }
+
+//addKey adds priv unless a key of the same public key is present.
+func (b *KeyBag) addKey(priv *types.PrivateKey) error {
+	if b.Present(priv.PublicKey()) {
+		return nil
+	}
+
+	if err := b.hold(priv); err != nil {
+		return errors.Annotate(err, "hold")
+	}
+
+	b.keys = append(b.keys, priv)
+	return nil
+}
+
+//Close wipes all keys and empties the bag.
+func (b *KeyBag) Close() {
+	b.zero()
+}
+
+//zero overwrites all keys in bag and empties it.
+func (b *KeyBag) zero() {
+	for _, k := range b.keys {
+		k.Zero()
+	}
+	b.keys = b.keys[:0]
+}
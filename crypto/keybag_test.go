@@ -0,0 +1,61 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/denkhaus/bitshares/config"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHardenedKeyBag(t *testing.T) {
+	config.SetCurrent(config.ChainIDGPH)
+
+	bag := NewHardenedKeyBag()
+	assert.True(t, bag.Hardened())
+
+	privs := make([]*types.PrivateKey, 2)
+	for idx := range privs {
+		priv, err := types.GeneratePrivateKey()
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		privs[idx] = priv
+		if !assert.NoError(t, bag.Add(priv.ToWIF())) {
+			return
+		}
+	}
+
+	pub := privs[0].PublicKey()
+	assert.True(t, bag.Present(pub))
+	assert.Nil(t, bag.Private(pub))
+	assert.Nil(t, bag.Privates())
+	assert.Nil(t, bag.PrivatesByPublics(bag.Publics()))
+
+	held := bag.private(pub)
+	if assert.NotNil(t, held) {
+		assert.True(t, held.Locked())
+	}
+
+	digest := make([]byte, 32)
+	sig, err := bag.SignCompact(pub, digest)
+	if assert.NoError(t, err) {
+		assert.True(t, isCanonical(sig))
+	}
+
+	assert.True(t, bag.Remove(pub.String()))
+	assert.False(t, held.Locked())
+	assert.Empty(t, held.ToWIF())
+	assert.False(t, bag.Present(pub))
+
+	last := bag.private(privs[1].PublicKey())
+	bag.Close()
+	assert.Empty(t, bag.Publics())
+	assert.Empty(t, last.ToWIF())
+
+	plain := NewKeyBag()
+	assert.NoError(t, plain.Add(privs[1].ToWIF()))
+	assert.Equal(t, privs[1].ToWIF(), plain.Private(privs[1].PublicKey()).ToWIF())
+	assert.False(t, plain.private(privs[1].PublicKey()).Locked())
+}
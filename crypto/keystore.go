@@ -0,0 +1,590 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/denkhaus/bitshares/types"
+	"github.com/denkhaus/bitshares/util"
+	"github.com/juju/errors"
+	"github.com/pquerna/ffjson/ffjson"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	KeyStoreVersion = 1
+
+	//KeyStoreScryptN, KeyStoreScryptR and KeyStoreScryptP are the scrypt parameters of new key stores.
+	KeyStoreScryptN = 1 << 18
+	KeyStoreScryptR = 8
+	KeyStoreScryptP = 1
+
+	//KeyStoreMaxScryptN, KeyStoreMaxScryptR and KeyStoreMaxScryptP bound the scrypt parameters
+	//of key stores read, so a crafted file can't exhaust memory or CPU on Unlock.
+	KeyStoreMaxScryptN = 1 << 20
+	KeyStoreMaxScryptR = 8
+	KeyStoreMaxScryptP = 16
+
+	keyStoreKDF     = "scrypt"
+	keyStoreCipher  = "aes-256-gcm"
+	keyStoreKeyLen  = 32
+	keyStoreSaltLen = 32
+)
+
+var (
+	ErrKeyStoreLocked      = errors.New("key store is locked")
+	ErrKeyStoreNew         = errors.New("key store has no password set")
+	ErrInvalidPassword     = errors.New("invalid password")
+	ErrKeyStoreCorrupted   = errors.New("key store is corrupted")
+	ErrKeyAlreadyPresent   = errors.New("key already present")
+	ErrKeyNotFound         = errors.New("key not found")
+	ErrUnsupportedKeyStore = errors.New("unsupported key store format")
+	ErrKeyStoreKDFParams   = errors.New("key store scrypt parameters out of range")
+)
+
+//KeyStoreEntry describes a key of a KeyStore. Entries are readable while the store is locked.
+type KeyStoreEntry struct {
+	PubKey   types.PublicKey  `json:"pub_key"`
+	Label    string           `json:"label,omitempty"`
+	Accounts types.AccountIDs `json:"accounts,omitempty"`
+}
+
+type keyStoreKDFParams struct {
+	Name string `json:"name"`
+	Salt string `json:"salt"`
+	N    int    `json:"n"`
+	R    int    `json:"r"`
+	P    int    `json:"p"`
+}
+
+type keyStoreFile struct {
+	Version    int               `json:"version"`
+	KDF        keyStoreKDFParams `json:"kdf"`
+	Cipher     string            `json:"cipher"`
+	Nonce      string            `json:"nonce"`
+	CipherText string            `json:"cipher_text"`
+	Keys       json.RawMessage   `json:"keys"`
+}
+
+//KeyStore is a password encrypted, persistable KeyBag.
+//The keys are encrypted with AES-256-GCM using a scrypt derived key,
+//the entries are authenticated too but stay readable while locked.
+type KeyStore struct {
+	mu      sync.RWMutex
+	entries []KeyStoreEntry
+	file    keyStoreFile
+	secret  []byte
+	bag     *KeyBag
+}
+
+//NewKeyStore creates an empty KeyStore. Use SetPassword to unlock it for the first time.
+func NewKeyStore() *KeyStore {
+	return &KeyStore{
+		file: keyStoreFile{
+			Version: KeyStoreVersion,
+			Cipher:  keyStoreCipher,
+			KDF: keyStoreKDFParams{
+				Name: keyStoreKDF,
+				N:    KeyStoreScryptN,
+				R:    KeyStoreScryptR,
+				P:    KeyStoreScryptP,
+			},
+		},
+	}
+}
+
+//ReadKeyStore reads a locked KeyStore from r.
+func ReadKeyStore(r io.Reader) (*KeyStore, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Annotate(err, "ReadAll")
+	}
+
+	ks := KeyStore{}
+	if err := ffjson.Unmarshal(data, &ks.file); err != nil {
+		return nil, errors.Annotate(err, "Unmarshal [keyStoreFile]")
+	}
+
+	if ks.file.Version != KeyStoreVersion ||
+		ks.file.KDF.Name != keyStoreKDF ||
+		ks.file.Cipher != keyStoreCipher {
+		return nil, ErrUnsupportedKeyStore
+	}
+
+	if err := ks.file.KDF.validate(); err != nil {
+		return nil, errors.Annotate(err, "validate [kdf]")
+	}
+
+	if err := ffjson.Unmarshal(ks.file.Keys, &ks.entries); err != nil {
+		return nil, errors.Annotate(err, "Unmarshal [entries]")
+	}
+
+	return &ks, nil
+}
+
+//OpenKeyStore reads a locked KeyStore from path.
+func OpenKeyStore(path string) (*KeyStore, error) {
+	inFile, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Errorf("open key store [%s], %s", path, err)
+	}
+	defer inFile.Close()
+
+	return ReadKeyStore(inFile)
+}
+
+//IsNew returns true if no password has been set yet.
+func (p *KeyStore) IsNew() bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.file.CipherText == "" && p.secret == nil
+}
+
+//IsLocked returns true if the keys are not accessible.
+func (p *KeyStore) IsLocked() bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.bag == nil
+}
+
+//SetPassword sets the password of a new KeyStore and unlocks it,
+//or changes the password of an unlocked KeyStore.
+func (p *KeyStore) SetPassword(password string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.bag == nil {
+		if p.file.CipherText != "" {
+			return ErrKeyStoreLocked
+		}
+		p.bag = NewKeyBag()
+	}
+
+	salt := make([]byte, keyStoreSaltLen)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return errors.Annotate(err, "ReadFull")
+	}
+
+	kdf := p.file.KDF
+	kdf.Salt = hex.EncodeToString(salt)
+
+	secret, err := deriveKeyStoreSecret(password, kdf)
+	if err != nil {
+		return errors.Annotate(err, "deriveKeyStoreSecret")
+	}
+
+	zeroBytes(p.secret)
+	p.secret = secret
+	p.file.KDF = kdf
+
+	return p.seal()
+}
+
+//Unlock decrypts the keys with password.
+func (p *KeyStore) Unlock(password string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.bag != nil {
+		return nil
+	}
+
+	if p.file.CipherText == "" {
+		return ErrKeyStoreNew
+	}
+
+	secret, err := deriveKeyStoreSecret(password, p.file.KDF)
+	if err != nil {
+		return errors.Annotate(err, "deriveKeyStoreSecret")
+	}
+
+	plain, err := p.open(secret)
+	if err != nil {
+		zeroBytes(secret)
+		return err
+	}
+	defer zeroBytes(plain)
+
+	bag := NewKeyBag()
+	if err := bag.Unmarshal(util.NewTypeDecoder(bytes.NewReader(plain))); err != nil {
+		zeroBytes(secret)
+		return errors.Annotate(err, "Unmarshal [KeyBag]")
+	}
+
+	if len(bag.keys) != len(p.entries) {
+		zeroBytes(secret)
+		bag.zero()
+		return ErrKeyStoreCorrupted
+	}
+
+	for idx, key := range bag.keys {
+		if !key.PublicKey().Equal(&p.entries[idx].PubKey) {
+			zeroBytes(secret)
+			bag.zero()
+			return ErrKeyStoreCorrupted
+		}
+	}
+
+	p.secret = secret
+	p.bag = bag
+	return nil
+}
+
+//Lock zeroes all decrypted key material. Keys obtained from the store before are unusable afterwards.
+func (p *KeyStore) Lock() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.bag != nil {
+		p.bag.zero()
+		p.bag = nil
+	}
+
+	zeroBytes(p.secret)
+	p.secret = nil
+	return nil
+}
+
+//Signer returns a read-only Signer view of the unlocked store. Keys are added and removed through the
+//KeyStore only, the view fails with ErrKeyStoreLocked once the store is locked.
+func (p *KeyStore) Signer() (Signer, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.bag == nil {
+		return nil, ErrKeyStoreLocked
+	}
+
+	return keyStoreSigner{store: p}, nil
+}
+
+//keyStoreSigner signs with the keys of a KeyStore without exposing its KeyBag.
+type keyStoreSigner struct {
+	store *KeyStore
+}
+
+func (p keyStoreSigner) Publics() types.PublicKeys {
+	p.store.mu.RLock()
+	defer p.store.mu.RUnlock()
+
+	if p.store.bag == nil {
+		return nil
+	}
+
+	return p.store.bag.Publics()
+}
+
+func (p keyStoreSigner) SignCompact(pub *types.PublicKey, digest []byte) ([]byte, error) {
+	p.store.mu.RLock()
+	defer p.store.mu.RUnlock()
+
+	if p.store.bag == nil {
+		return nil, ErrKeyStoreLocked
+	}
+
+	return p.store.bag.SignCompact(pub, digest)
+}
+
+func (p keyStoreSigner) SharedSecret(pub, counterparty *types.PublicKey, skLen, macLen int) ([]byte, error) {
+	p.store.mu.RLock()
+	defer p.store.mu.RUnlock()
+
+	if p.store.bag == nil {
+		return nil, ErrKeyStoreLocked
+	}
+
+	return p.store.bag.SharedSecret(pub, counterparty, skLen, macLen)
+}
+
+//Entries returns the key entries of the store.
+func (p *KeyStore) Entries() []KeyStoreEntry {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	ret := make([]KeyStoreEntry, len(p.entries))
+	copy(ret, p.entries)
+	return ret
+}
+
+//PublicsByAccount returns the public keys associated with account.
+func (p *KeyStore) PublicsByAccount(account types.GrapheneObject) (out types.PublicKeys) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	for _, entry := range p.entries {
+		for _, acct := range entry.Accounts {
+			if acct.ID() == account.ID() {
+				out = append(out, entry.PubKey)
+				break
+			}
+		}
+	}
+
+	return
+}
+
+//Add adds wifKey with label to the unlocked store.
+func (p *KeyStore) Add(wifKey, label string) error {
+	priv, err := types.NewPrivateKeyFromWif(wifKey)
+	if err != nil {
+		return errors.Annotate(err, "NewPrivateKeyFromWif")
+	}
+
+	return p.AddKey(priv, label)
+}
+
+//AddKey adds priv with label to the unlocked store.
+func (p *KeyStore) AddKey(priv *types.PrivateKey, label string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.bag == nil {
+		return ErrKeyStoreLocked
+	}
+
+	if p.find(priv.PublicKey()) >= 0 {
+		return ErrKeyAlreadyPresent
+	}
+
+	// the store owns a copy, zeroing it on lock leaves priv intact
+	key, err := types.NewPrivateKeyFromWif(priv.ToWIF())
+	if err != nil {
+		return errors.Annotate(err, "NewPrivateKeyFromWif")
+	}
+
+	p.bag.keys = append(p.bag.keys, key)
+	p.entries = append(p.entries, KeyStoreEntry{
+		PubKey: *priv.PublicKey(),
+		Label:  label,
+	})
+
+	return p.seal()
+}
+
+//Remove removes the key of pub from the unlocked store and zeroes it.
+func (p *KeyStore) Remove(pub *types.PublicKey) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	idx, err := p.mustFind(pub)
+	if err != nil {
+		return err
+	}
+
+	p.bag.keys[idx].Zero()
+	p.bag.keys = append(p.bag.keys[:idx], p.bag.keys[idx+1:]...)
+	p.entries = append(p.entries[:idx], p.entries[idx+1:]...)
+
+	return p.seal()
+}
+
+//SetLabel sets the label of the key of pub.
+func (p *KeyStore) SetLabel(pub *types.PublicKey, label string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	idx, err := p.mustFind(pub)
+	if err != nil {
+		return err
+	}
+
+	p.entries[idx].Label = label
+	return p.seal()
+}
+
+//Associate associates the key of pub with account.
+func (p *KeyStore) Associate(pub *types.PublicKey, account types.GrapheneObject) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	idx, err := p.mustFind(pub)
+	if err != nil {
+		return err
+	}
+
+	for _, acct := range p.entries[idx].Accounts {
+		if acct.ID() == account.ID() {
+			return nil
+		}
+	}
+
+	p.entries[idx].Accounts = append(p.entries[idx].Accounts, types.AccountIDFromObject(account))
+	return p.seal()
+}
+
+//WriteTo writes the encrypted store to w.
+func (p *KeyStore) WriteTo(w io.Writer) (int64, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.file.CipherText == "" {
+		return 0, ErrKeyStoreNew
+	}
+
+	data, err := ffjson.Marshal(p.file)
+	if err != nil {
+		return 0, errors.Annotate(err, "Marshal [keyStoreFile]")
+	}
+
+	n, err := w.Write(data)
+	return int64(n), err
+}
+
+//Save writes the encrypted store to path, replacing an existing file atomically.
+func (p *KeyStore) Save(path string) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return errors.Annotate(err, "TempFile")
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := p.WriteTo(tmp); err != nil {
+		tmp.Close()
+		return errors.Annotate(err, "WriteTo")
+	}
+
+	if err := tmp.Close(); err != nil {
+		return errors.Annotate(err, "Close")
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return errors.Annotate(err, "Rename")
+	}
+
+	return nil
+}
+
+func (p *KeyStore) find(pub *types.PublicKey) int {
+	for idx, entry := range p.entries {
+		if entry.PubKey.Equal(pub) {
+			return idx
+		}
+	}
+
+	return -1
+}
+
+func (p *KeyStore) mustFind(pub *types.PublicKey) (int, error) {
+	if p.bag == nil {
+		return -1, ErrKeyStoreLocked
+	}
+
+	idx := p.find(pub)
+	if idx < 0 {
+		return -1, ErrKeyNotFound
+	}
+
+	return idx, nil
+}
+
+//seal encrypts the keys and authenticates the entries with a fresh nonce.
+func (p *KeyStore) seal() error {
+	keys, err := ffjson.Marshal(p.entries)
+	if err != nil {
+		return errors.Annotate(err, "Marshal [entries]")
+	}
+
+	var buf bytes.Buffer
+	if err := p.bag.Marshal(util.NewTypeEncoder(&buf)); err != nil {
+		return errors.Annotate(err, "Marshal [KeyBag]")
+	}
+	plain := buf.Bytes()
+	defer zeroBytes(plain)
+
+	aead, err := newKeyStoreAEAD(p.secret)
+	if err != nil {
+		return errors.Annotate(err, "newKeyStoreAEAD")
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return errors.Annotate(err, "ReadFull")
+	}
+
+	p.file.Keys = keys
+	p.file.Nonce = hex.EncodeToString(nonce)
+	p.file.CipherText = hex.EncodeToString(aead.Seal(nil, nonce, plain, keys))
+	return nil
+}
+
+func (p *KeyStore) open(secret []byte) ([]byte, error) {
+	nonce, err := hex.DecodeString(p.file.Nonce)
+	if err != nil {
+		return nil, ErrKeyStoreCorrupted
+	}
+
+	cipherText, err := hex.DecodeString(p.file.CipherText)
+	if err != nil {
+		return nil, ErrKeyStoreCorrupted
+	}
+
+	aead, err := newKeyStoreAEAD(secret)
+	if err != nil {
+		return nil, errors.Annotate(err, "newKeyStoreAEAD")
+	}
+
+	if len(nonce) != aead.NonceSize() {
+		return nil, ErrKeyStoreCorrupted
+	}
+
+	// a wrong password and tampered data are indistinguishable
+	plain, err := aead.Open(nil, nonce, cipherText, p.file.Keys)
+	if err != nil {
+		return nil, ErrInvalidPassword
+	}
+
+	return plain, nil
+}
+
+//validate rejects scrypt parameters beyond the KeyStoreMaxScrypt bounds.
+func (p keyStoreKDFParams) validate() error {
+	if p.N <= 1 || p.N > KeyStoreMaxScryptN ||
+		p.R < 1 || p.R > KeyStoreMaxScryptR ||
+		p.P < 1 || p.P > KeyStoreMaxScryptP {
+		return errors.Annotatef(ErrKeyStoreKDFParams, "n=%d r=%d p=%d", p.N, p.R, p.P)
+	}
+
+	return nil
+}
+
+func deriveKeyStoreSecret(password string, kdf keyStoreKDFParams) ([]byte, error) {
+	if err := kdf.validate(); err != nil {
+		return nil, errors.Annotate(err, "validate")
+	}
+
+	salt, err := hex.DecodeString(kdf.Salt)
+	if err != nil || len(salt) == 0 {
+		return nil, ErrKeyStoreCorrupted
+	}
+
+	secret, err := scrypt.Key([]byte(password), salt, kdf.N, kdf.R, kdf.P, keyStoreKeyLen)
+	if err != nil {
+		return nil, errors.Annotate(err, "scrypt")
+	}
+
+	return secret, nil
+}
+
+func newKeyStoreAEAD(secret []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(secret)
+	if err != nil {
+		return nil, errors.Annotate(err, "NewCipher")
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func zeroBytes(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
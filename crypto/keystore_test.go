@@ -0,0 +1,199 @@
+package crypto
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/denkhaus/bitshares/config"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestKeyStore() *KeyStore {
+	ks := NewKeyStore()
+	ks.file.KDF.N = 1 << 10
+	return ks
+}
+
+func TestKeyStoreLockUnlock(t *testing.T) {
+	config.SetCurrent(config.ChainIDGPH)
+
+	ks := newTestKeyStore()
+	assert.True(t, ks.IsNew())
+	assert.True(t, ks.IsLocked())
+	assert.Equal(t, ErrKeyStoreNew, ks.Unlock("secret"))
+
+	if !assert.NoError(t, ks.SetPassword("secret")) {
+		return
+	}
+
+	assert.False(t, ks.IsNew())
+	assert.False(t, ks.IsLocked())
+
+	priv, err := types.GeneratePrivateKey()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.NoError(t, ks.AddKey(priv, "trading"))
+	assert.Equal(t, ErrKeyAlreadyPresent, ks.Add(priv.ToWIF(), "again"))
+
+	account := types.NewAccountID("1.2.100")
+	assert.NoError(t, ks.Associate(priv.PublicKey(), account))
+	assert.NoError(t, ks.Associate(priv.PublicKey(), account))
+
+	held := ks.bag.Private(priv.PublicKey())
+	if !assert.NotNil(t, held) {
+		return
+	}
+
+	assert.NoError(t, ks.Lock())
+	assert.True(t, ks.IsLocked())
+	assert.Equal(t, 0, held.ECPrivateKey().D.Sign(), "key material is zeroed on lock")
+	assert.NotEqual(t, 0, priv.ECPrivateKey().D.Sign(), "the added key is copied")
+
+	_, err = ks.Signer()
+	assert.Equal(t, ErrKeyStoreLocked, err)
+	assert.Equal(t, ErrKeyStoreLocked, ks.SetLabel(priv.PublicKey(), "x"))
+
+	// entries stay readable while locked
+	entries := ks.Entries()
+	if assert.Len(t, entries, 1) {
+		assert.Equal(t, "trading", entries[0].Label)
+		assert.Len(t, entries[0].Accounts, 1)
+	}
+	assert.Len(t, ks.PublicsByAccount(account), 1)
+
+	assert.Equal(t, ErrInvalidPassword, ks.Unlock("wrong"))
+	if assert.NoError(t, ks.Unlock("secret")) {
+		assert.Equal(t, priv.ToWIF(), ks.bag.Private(priv.PublicKey()).ToWIF())
+	}
+}
+
+func TestKeyStoreSigner(t *testing.T) {
+	config.SetCurrent(config.ChainIDGPH)
+
+	ks := newTestKeyStore()
+	if !assert.NoError(t, ks.SetPassword("secret")) {
+		return
+	}
+
+	priv, err := types.GeneratePrivateKey()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.NoError(t, ks.AddKey(priv, "trading"))
+
+	keys, err := ks.Signer()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// the view does not hand out the bag backing the store
+	_, isBag := keys.(*KeyBag)
+	assert.False(t, isBag)
+	_, isBag = keys.(KeyBag)
+	assert.False(t, isBag)
+
+	digest := make([]byte, 32)
+	_, err = keys.SignCompact(priv.PublicKey(), digest)
+	assert.NoError(t, err)
+
+	// mutations go through the store and show up in the view
+	other, err := types.GeneratePrivateKey()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.NoError(t, ks.AddKey(other, "savings"))
+	assert.Len(t, keys.Publics(), 2)
+	assert.NoError(t, ks.Remove(priv.PublicKey()))
+	assert.Len(t, keys.Publics(), 1)
+	assert.NoError(t, ks.SetLabel(other.PublicKey(), "cold"))
+
+	assert.NoError(t, ks.Lock())
+	assert.Len(t, keys.Publics(), 0)
+	_, err = keys.SignCompact(other.PublicKey(), digest)
+	assert.Equal(t, ErrKeyStoreLocked, err)
+
+	// the sealed keys still match the entries
+	if assert.NoError(t, ks.Unlock("secret")) {
+		assert.Equal(t, types.PublicKeys{*other.PublicKey()}, keys.Publics())
+		_, err = keys.SignCompact(other.PublicKey(), digest)
+		assert.NoError(t, err)
+		if entries := ks.Entries(); assert.Len(t, entries, 1) {
+			assert.Equal(t, "cold", entries[0].Label)
+		}
+	}
+}
+
+func TestKeyStorePersistence(t *testing.T) {
+	config.SetCurrent(config.ChainIDGPH)
+
+	dir, err := ioutil.TempDir("", "keystore")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	ks := newTestKeyStore()
+	if !assert.NoError(t, ks.SetPassword("secret")) {
+		return
+	}
+
+	priv, err := types.GeneratePrivateKey()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.NoError(t, ks.AddKey(priv, "trading"))
+
+	path := filepath.Join(dir, "keys.json")
+	if !assert.NoError(t, ks.Save(path)) {
+		return
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NotContains(t, string(data), priv.ToWIF())
+
+	loaded, err := OpenKeyStore(path)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.True(t, loaded.IsLocked())
+	if assert.NoError(t, loaded.Unlock("secret")) {
+		assert.NoError(t, loaded.SetPassword("changed"))
+		assert.NoError(t, loaded.Lock())
+		assert.Equal(t, ErrInvalidPassword, loaded.Unlock("secret"))
+		assert.NoError(t, loaded.Unlock("changed"))
+	}
+
+	// tampering with the entries is detected
+	tampered, err := ReadKeyStore(bytes.NewReader(
+		[]byte(strings.Replace(string(data), "trading", "savings", 1)),
+	))
+	if assert.NoError(t, err) {
+		assert.Equal(t, ErrInvalidPassword, tampered.Unlock("secret"))
+	}
+
+	// excessive scrypt parameters are refused before deriving anything
+	for _, param := range []string{`"n":1024`, `"r":8`, `"p":1`} {
+		huge := strings.Replace(string(data), param, strings.SplitN(param, ":", 2)[0]+":1073741824", 1)
+		if !assert.NotEqual(t, string(data), huge, param) {
+			continue
+		}
+
+		_, err = ReadKeyStore(bytes.NewReader([]byte(huge)))
+		assert.Equal(t, ErrKeyStoreKDFParams, errors.Cause(err), param)
+	}
+}
@@ -0,0 +1,47 @@
+package crypto
+
+import (
+	"github.com/denkhaus/bitshares/operations"
+	"github.com/denkhaus/bitshares/types"
+)
+
+//MemoHistory is an OperationHistory with its decrypted memo attached.
+type MemoHistory struct {
+	types.OperationHistory
+	//Memo is the decrypted memo, empty if the operation has none.
+	Memo string
+	//MemoErr is set if the operation has a memo that could not be decrypted.
+	MemoErr error
+}
+
+type MemoHistories []MemoHistory
+
+//OperationMemo returns the memo of transfer, override transfer and
+//withdraw permission claim operations or nil.
+func OperationMemo(op types.Operation) *types.Memo {
+	switch op := op.(type) {
+	case *operations.TransferOperation:
+		return op.Memo
+	case *operations.OverrideTransferOperation:
+		return op.Memo
+	case *operations.WithdrawPermissionClaimOperation:
+		return op.Memo
+	}
+
+	return nil
+}
+
+//DecryptHistoryMemos attaches the memos of hists decrypted with keys held by signer.
+func DecryptHistoryMemos(signer Signer, hists types.OperationHistories) MemoHistories {
+	ret := make(MemoHistories, 0, len(hists))
+	for _, hist := range hists {
+		mh := MemoHistory{OperationHistory: hist}
+		if memo := OperationMemo(hist.Operation.Operation); memo != nil {
+			mh.Memo, mh.MemoErr = DecryptMemo(signer, memo)
+		}
+
+		ret = append(ret, mh)
+	}
+
+	return ret
+}
@@ -0,0 +1,106 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/aes"
+	"testing"
+
+	"github.com/denkhaus/bitshares/config"
+	"github.com/denkhaus/bitshares/operations"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecryptHistoryMemos(t *testing.T) {
+	config.SetCurrent(config.ChainIDGPH)
+
+	bags := make([]*KeyBag, 3)
+	for idx := range bags {
+		priv, err := types.GeneratePrivateKey()
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		bags[idx] = NewKeyBag()
+		assert.NoError(t, bags[idx].Add(priv.ToWIF()))
+	}
+
+	sender, receiver, stranger := bags[0], bags[1], bags[2]
+	memo := types.Memo{
+		From:  sender.Publics()[0],
+		To:    receiver.Publics()[0],
+		Nonce: 42,
+	}
+
+	if !assert.NoError(t, sender.EncryptMemo(&memo, "invoice 1234")) {
+		return
+	}
+
+	for _, bag := range []*KeyBag{sender, receiver} {
+		msg, err := bag.DecryptMemo(&memo)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "invoice 1234", msg)
+		}
+	}
+
+	_, err := stranger.DecryptMemo(&memo)
+	assert.Equal(t, ErrKeyNotFound, err)
+
+	hists := types.OperationHistories{
+		{Operation: types.OperationEnvelope{Operation: &operations.TransferOperation{Memo: &memo}}},
+		{Operation: types.OperationEnvelope{Operation: &operations.TransferOperation{}}},
+		{Operation: types.OperationEnvelope{Operation: &operations.LimitOrderCreateOperation{}}},
+		{Operation: types.OperationEnvelope{Operation: &operations.WithdrawPermissionClaimOperation{Memo: &memo}}},
+	}
+
+	decrypted := DecryptHistoryMemos(receiver, hists)
+	if assert.Len(t, decrypted, 4) {
+		assert.Equal(t, "invoice 1234", decrypted[0].Memo)
+		assert.Empty(t, decrypted[1].Memo)
+		assert.Empty(t, decrypted[2].Memo)
+		assert.Equal(t, "invoice 1234", decrypted[3].Memo)
+		for _, hist := range decrypted {
+			assert.NoError(t, hist.MemoErr)
+		}
+	}
+
+	decrypted = DecryptHistoryMemos(stranger, hists)
+	assert.Equal(t, ErrKeyNotFound, decrypted[0].MemoErr)
+	assert.NoError(t, decrypted[1].MemoErr)
+}
+
+func TestDecryptHistoryMemosMalformed(t *testing.T) {
+	config.SetCurrent(config.ChainIDGPH)
+
+	priv, err := types.GeneratePrivateKey()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	bag := NewKeyBag()
+	assert.NoError(t, bag.Add(priv.ToWIF()))
+
+	//junk ciphertext decrypts to random pad counts, mostly beyond the buffer
+	hists := types.OperationHistories{}
+	for nonce := 1; nonce <= 16; nonce++ {
+		memo := types.Memo{
+			From:    *priv.PublicKey(),
+			To:      *priv.PublicKey(),
+			Nonce:   types.UInt64(nonce),
+			Message: types.Buffer(bytes.Repeat([]byte{byte(nonce)}, aes.BlockSize)),
+		}
+
+		hists = append(hists, types.OperationHistory{
+			Operation: types.OperationEnvelope{Operation: &operations.TransferOperation{Memo: &memo}},
+		})
+	}
+
+	decrypted := DecryptHistoryMemos(bag, hists)
+	if assert.Len(t, decrypted, len(hists)) {
+		for _, hist := range decrypted {
+			assert.Equal(t, types.ErrInvalidChecksum, errors.Cause(hist.MemoErr))
+			assert.Empty(t, hist.Memo)
+		}
+	}
+}
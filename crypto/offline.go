@@ -0,0 +1,199 @@
+package crypto
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io/ioutil"
+	"time"
+
+	"github.com/denkhaus/bitshares/config"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
+	"github.com/pquerna/ffjson/ffjson"
+)
+
+var (
+	ErrTransactionExpired  = errors.New("transaction expired")
+	ErrTransactionMismatch = errors.New("transaction does not match its serialized form")
+	ErrTransactionUnsigned = errors.New("transaction is not signed")
+)
+
+//OfflineTransaction carries a prepared transaction to an air-gapped signer and back.
+//Serialized and Digest are created by the preparing side. Verify makes sure the transaction
+//a signer decodes, displays and signs is exactly the serialized one.
+type OfflineTransaction struct {
+	ChainID      string                   `json:"chain_id"`
+	Transaction  *types.SignedTransaction `json:"transaction"`
+	RequiredKeys types.PublicKeys         `json:"required_keys"`
+	Serialized   string                   `json:"serialized"`
+	Digest       string                   `json:"digest"`
+}
+
+//NewOfflineTransaction creates an OfflineTransaction from a transaction with fees and block data applied.
+func NewOfflineTransaction(tx *types.SignedTransaction, chain *config.ChainConfig, required types.PublicKeys) (*OfflineTransaction, error) {
+	raw, err := tx.SerializeTrx()
+	if err != nil {
+		return nil, errors.Annotate(err, "SerializeTrx")
+	}
+
+	digest, err := tx.Digest(chain)
+	if err != nil {
+		return nil, errors.Annotate(err, "Digest")
+	}
+
+	return &OfflineTransaction{
+		ChainID:      chain.ID,
+		Transaction:  tx,
+		RequiredKeys: required,
+		Serialized:   hex.EncodeToString(raw),
+		Digest:       hex.EncodeToString(digest),
+	}, nil
+}
+
+//ReadOfflineTransaction decodes and verifies an OfflineTransaction.
+func ReadOfflineTransaction(data []byte) (*OfflineTransaction, error) {
+	otx := OfflineTransaction{}
+	if err := ffjson.Unmarshal(data, &otx); err != nil {
+		return nil, errors.Annotate(err, "Unmarshal")
+	}
+
+	if err := otx.Verify(); err != nil {
+		return nil, errors.Annotate(err, "Verify")
+	}
+
+	return &otx, nil
+}
+
+//OpenOfflineTransaction reads an OfflineTransaction from file path.
+func OpenOfflineTransaction(path string) (*OfflineTransaction, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Annotate(err, "ReadFile")
+	}
+
+	return ReadOfflineTransaction(data)
+}
+
+//Chain returns the config of the transaction's chain.
+func (p *OfflineTransaction) Chain() (*config.ChainConfig, error) {
+	chain := config.FindByID(p.ChainID)
+	if chain == nil {
+		return nil, errors.Annotate(ErrUnknownChain, p.ChainID)
+	}
+
+	return chain, nil
+}
+
+//Verify checks that the decoded transaction serializes to Serialized, hashes to Digest
+//and contains only supported operations.
+func (p *OfflineTransaction) Verify() error {
+	if p.Transaction == nil {
+		return errors.New("no transaction")
+	}
+
+	for idx, op := range p.Transaction.Operations {
+		if op == nil {
+			return errors.Annotatef(ErrUnsupportedOperation, "operation %d", idx)
+		}
+	}
+
+	chain, err := p.Chain()
+	if err != nil {
+		return errors.Annotate(err, "Chain")
+	}
+
+	raw, err := p.Transaction.SerializeTrx()
+	if err != nil {
+		return errors.Annotate(err, "SerializeTrx")
+	}
+
+	serialized, err := hex.DecodeString(p.Serialized)
+	if err != nil {
+		return errors.Annotate(err, "DecodeString [serialized]")
+	}
+
+	if !bytes.Equal(raw, serialized) {
+		return ErrTransactionMismatch
+	}
+
+	digest, err := p.Transaction.Digest(chain)
+	if err != nil {
+		return errors.Annotate(err, "Digest")
+	}
+
+	if hex.EncodeToString(digest) != p.Digest {
+		return ErrTransactionMismatch
+	}
+
+	return nil
+}
+
+//Sign verifies the transaction and signs it with all required keys held by signer.
+//The transaction is never modified, so each signature must be canonical at once.
+func (p *OfflineTransaction) Sign(signer Signer, now time.Time) (types.PublicKeys, error) {
+	if err := p.Verify(); err != nil {
+		return nil, errors.Annotate(err, "Verify")
+	}
+
+	if !p.Transaction.Expiration.After(now) {
+		return nil, ErrTransactionExpired
+	}
+
+	env := SignatureEnvelope{
+		ChainID:      p.ChainID,
+		Transaction:  p.Transaction,
+		RequiredKeys: p.RequiredKeys,
+	}
+
+	signed, err := env.Sign(signer)
+	if err != nil {
+		return nil, errors.Annotate(err, "Sign")
+	}
+
+	if len(signed) == 0 {
+		return nil, types.ErrNoSigningKeyFound
+	}
+
+	return signed, nil
+}
+
+//SignedTransaction verifies the transaction including its signatures and returns it for broadcasting.
+func (p *OfflineTransaction) SignedTransaction(now time.Time) (*types.SignedTransaction, error) {
+	if err := p.Verify(); err != nil {
+		return nil, errors.Annotate(err, "Verify")
+	}
+
+	if !p.Transaction.Expiration.After(now) {
+		return nil, ErrTransactionExpired
+	}
+
+	if len(p.Transaction.Signatures) == 0 {
+		return nil, ErrTransactionUnsigned
+	}
+
+	env := SignatureEnvelope{
+		ChainID:      p.ChainID,
+		Transaction:  &types.SignedTransaction{Transaction: p.Transaction.Transaction},
+		RequiredKeys: p.RequiredKeys,
+	}
+
+	if err := env.AddSignatures(p.Transaction.Signatures...); err != nil {
+		return nil, errors.Annotate(err, "AddSignatures")
+	}
+
+	return p.Transaction, nil
+}
+
+//Save writes the transaction as JSON to path.
+func (p *OfflineTransaction) Save(path string) error {
+	data, err := ffjson.Marshal(p)
+	if err != nil {
+		return errors.Annotate(err, "Marshal")
+	}
+
+	if err := ioutil.WriteFile(path, data, 0600); err != nil {
+		return errors.Annotate(err, "WriteFile")
+	}
+
+	return nil
+}
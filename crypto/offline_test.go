@@ -0,0 +1,80 @@
+package crypto
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/denkhaus/bitshares/config"
+	"github.com/denkhaus/bitshares/operations"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/pquerna/ffjson/ffjson"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOfflineTransaction(t *testing.T) {
+	config.SetCurrent(config.ChainIDGPH)
+
+	priv, err := types.GeneratePrivateKey()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	bag := NewKeyBag()
+	assert.NoError(t, bag.Add(priv.ToWIF()))
+
+	tx := transferFrom("1.2.100")
+	tx.Operations[0].(*operations.TransferOperation).Amount = types.AssetAmount{
+		Asset:  types.AssetIDFromObject(types.NewAssetID("1.3.0")),
+		Amount: 1000,
+	}
+
+	prepared, err := NewOfflineTransaction(tx, config.Current(), bag.Publics())
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	data, err := ffjson.Marshal(prepared)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// a transaction altered after preparation is refused
+	tampered := bytes.Replace(data, []byte(`"amount":1000`), []byte(`"amount":9000`), 1)
+	assert.NotEqual(t, data, tampered)
+	_, err = ReadOfflineTransaction(tampered)
+	assert.Error(t, err)
+
+	otx, err := ReadOfflineTransaction(data)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	_, err = otx.SignedTransaction(time.Now())
+	assert.Equal(t, ErrTransactionUnsigned, err)
+
+	_, err = otx.Sign(bag, time.Now().Add(time.Hour))
+	assert.Error(t, err, "expired")
+
+	signed, err := otx.Sign(bag, time.Now())
+	if assert.NoError(t, err) {
+		assert.Equal(t, bag.Publics(), signed)
+	}
+
+	data, err = ffjson.Marshal(otx)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	otx, err = ReadOfflineTransaction(data)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	ready, err := otx.SignedTransaction(time.Now())
+	if assert.NoError(t, err) {
+		verified, err := VerifySignedTransaction(bag, ready)
+		assert.NoError(t, err)
+		assert.True(t, verified)
+	}
+}
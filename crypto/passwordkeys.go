@@ -0,0 +1,115 @@
+package crypto
+
+import (
+	"crypto/sha256"
+
+	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
+)
+
+const (
+	KeyRoleOwner  = "owner"
+	KeyRoleActive = "active"
+	KeyRoleMemo   = "memo"
+)
+
+var (
+	ErrPasswordMismatch = errors.New("password does not match any account key")
+)
+
+//PasswordKeys holds the keys the reference UI derives from an account name and password.
+type PasswordKeys struct {
+	Owner  *types.PrivateKey
+	Active *types.PrivateKey
+	Memo   *types.PrivateKey
+}
+
+//PasswordKeyMatch reports which account keys match the derived keys.
+type PasswordKeyMatch struct {
+	Owner  bool
+	Active bool
+	Memo   bool
+}
+
+//Any returns true if at least one derived key matches.
+func (p PasswordKeyMatch) Any() bool {
+	return p.Owner || p.Active || p.Memo
+}
+
+//DerivePasswordKey derives the key of role from accountName and password,
+//which is sha256(accountName + role + password) like the reference UI's cloud wallet mode.
+func DerivePasswordKey(accountName, role, password string) (*types.PrivateKey, error) {
+	secret := sha256.Sum256([]byte(accountName + role + password))
+
+	priv, err := types.NewPrivateKeyFromSecret(secret[:])
+	if err != nil {
+		return nil, errors.Annotate(err, "NewPrivateKeyFromSecret")
+	}
+
+	return priv, nil
+}
+
+//DerivePasswordKeys derives owner, active and memo keys from accountName and password.
+func DerivePasswordKeys(accountName, password string) (*PasswordKeys, error) {
+	if accountName == "" || password == "" {
+		return nil, errors.New("account name and password required")
+	}
+
+	keys := PasswordKeys{}
+	for role, key := range map[string]**types.PrivateKey{
+		KeyRoleOwner:  &keys.Owner,
+		KeyRoleActive: &keys.Active,
+		KeyRoleMemo:   &keys.Memo,
+	} {
+		priv, err := DerivePasswordKey(accountName, role, password)
+		if err != nil {
+			return nil, errors.Annotatef(err, "DerivePasswordKey [%s]", role)
+		}
+
+		*key = priv
+	}
+
+	return &keys, nil
+}
+
+//Match checks the derived keys against account's owner and active key authorities and memo key.
+func (p PasswordKeys) Match(account *types.Account) PasswordKeyMatch {
+	return PasswordKeyMatch{
+		Owner:  hasKeyAuth(account.Owner, p.Owner.PublicKey()),
+		Active: hasKeyAuth(account.Active, p.Active.PublicKey()),
+		Memo:   account.Options.MemoKey.Equal(p.Memo.PublicKey()),
+	}
+}
+
+//KeyBag returns a KeyBag holding the derived keys.
+func (p PasswordKeys) KeyBag() *KeyBag {
+	bag := NewKeyBag()
+	bag.keys = append(bag.keys, p.Owner, p.Active, p.Memo)
+	return bag
+}
+
+//VerifyPasswordKeys derives the keys of account from password and verifies them against account.
+//ErrPasswordMismatch is returned if no key matches.
+func VerifyPasswordKeys(account *types.Account, password string) (*PasswordKeys, PasswordKeyMatch, error) {
+	keys, err := DerivePasswordKeys(account.Name.String(), password)
+	if err != nil {
+		return nil, PasswordKeyMatch{}, errors.Annotate(err, "DerivePasswordKeys")
+	}
+
+	match := keys.Match(account)
+	if !match.Any() {
+		return nil, match, ErrPasswordMismatch
+	}
+
+	return keys, match, nil
+}
+
+func hasKeyAuth(auth types.Authority, pub *types.PublicKey) bool {
+	for key := range auth.KeyAuths {
+		if key.Equal(pub) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,64 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/denkhaus/bitshares/config"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDerivePasswordKeys(t *testing.T) {
+	config.SetCurrent(config.ChainIDGPH)
+
+	keys, err := DerivePasswordKeys("alice", "P5secret")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	secret := sha256.Sum256([]byte("aliceactiveP5secret"))
+	active, err := types.NewPrivateKeyFromSecret(secret[:])
+	if assert.NoError(t, err) {
+		assert.Equal(t, active.ToWIF(), keys.Active.ToWIF())
+	}
+
+	assert.NotEqual(t, keys.Owner.ToWIF(), keys.Active.ToWIF())
+	assert.NotEqual(t, keys.Active.ToWIF(), keys.Memo.ToWIF())
+
+	_, err = DerivePasswordKeys("alice", "")
+	assert.Error(t, err)
+}
+
+func TestVerifyPasswordKeys(t *testing.T) {
+	config.SetCurrent(config.ChainIDGPH)
+
+	keys, err := DerivePasswordKeys("alice", "P5secret")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	other, err := types.GeneratePrivateKey()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	account := types.Account{}
+	if !assert.NoError(t, account.Name.UnmarshalJSON([]byte(`"alice"`))) {
+		return
+	}
+
+	account.Owner.KeyAuths = types.KeyAuthsMap{other.PublicKey(): 1}
+	account.Active.KeyAuths = types.KeyAuthsMap{keys.Active.PublicKey(): 1}
+	account.Options.MemoKey = *keys.Memo.PublicKey()
+
+	verified, match, err := VerifyPasswordKeys(&account, "P5secret")
+	if assert.NoError(t, err) {
+		assert.Equal(t, PasswordKeyMatch{Active: true, Memo: true}, match)
+		assert.True(t, verified.KeyBag().Present(keys.Active.PublicKey()))
+	}
+
+	_, match, err = VerifyPasswordKeys(&account, "wrong")
+	assert.Equal(t, ErrPasswordMismatch, err)
+	assert.False(t, match.Any())
+}
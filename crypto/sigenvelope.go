@@ -0,0 +1,296 @@
+package crypto
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io/ioutil"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/denkhaus/bitshares/config"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
+	"github.com/pquerna/ffjson/ffjson"
+)
+
+var (
+	ErrUnknownChain        = errors.New("unknown chain id")
+	ErrEnvelopeMismatch    = errors.New("envelopes hold different transactions")
+	ErrUnexpectedSignature = errors.New("signature of a key not required")
+)
+
+//SignatureEnvelope carries a transaction between the parties of a multisig authority.
+//Each party adds its signatures independently, envelopes of the same transaction can be merged.
+type SignatureEnvelope struct {
+	ChainID      string                   `json:"chain_id"`
+	Transaction  *types.SignedTransaction `json:"transaction"`
+	RequiredKeys types.PublicKeys         `json:"required_keys"`
+}
+
+//NewSignatureEnvelope creates a SignatureEnvelope for tx on chain to be signed by required.
+//The transaction must not change anymore, so its expiration should leave time to collect all signatures.
+func NewSignatureEnvelope(tx *types.SignedTransaction, chain *config.ChainConfig, required types.PublicKeys) *SignatureEnvelope {
+	return &SignatureEnvelope{
+		ChainID:      chain.ID,
+		Transaction:  tx,
+		RequiredKeys: required,
+	}
+}
+
+//ReadSignatureEnvelope decodes an envelope in JSON or hex encoded JSON and validates its signatures.
+func ReadSignatureEnvelope(data []byte) (*SignatureEnvelope, error) {
+	data = bytes.TrimSpace(data)
+	if !bytes.HasPrefix(data, []byte("{")) {
+		dec, err := hex.DecodeString(string(data))
+		if err != nil {
+			return nil, errors.Annotate(err, "DecodeString")
+		}
+		data = dec
+	}
+
+	env := SignatureEnvelope{}
+	if err := ffjson.Unmarshal(data, &env); err != nil {
+		return nil, errors.Annotate(err, "Unmarshal")
+	}
+
+	if env.Transaction == nil {
+		return nil, errors.New("envelope holds no transaction")
+	}
+
+	//re-add signatures to validate and deduplicate them
+	sigs := env.Transaction.Signatures
+	env.Transaction.Signatures = types.Signatures{}
+	if err := env.AddSignatures(sigs...); err != nil {
+		return nil, errors.Annotate(err, "AddSignatures")
+	}
+
+	return &env, nil
+}
+
+//OpenSignatureEnvelope reads an envelope from file path.
+func OpenSignatureEnvelope(path string) (*SignatureEnvelope, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Annotate(err, "ReadFile")
+	}
+
+	return ReadSignatureEnvelope(data)
+}
+
+//Chain returns the config of the envelope's chain.
+func (p *SignatureEnvelope) Chain() (*config.ChainConfig, error) {
+	chain := config.FindByID(p.ChainID)
+	if chain == nil {
+		return nil, errors.Annotate(ErrUnknownChain, p.ChainID)
+	}
+
+	return chain, nil
+}
+
+//Digest returns the digest the parties sign.
+func (p *SignatureEnvelope) Digest() ([]byte, error) {
+	chain, err := p.Chain()
+	if err != nil {
+		return nil, errors.Annotate(err, "Chain")
+	}
+
+	return p.Transaction.Digest(chain)
+}
+
+//Sign adds signatures of all required keys held by signer that didn't sign yet.
+//It returns the keys signed with. Signatures beyond the thresholds are dropped by Prune.
+func (p *SignatureEnvelope) Sign(signer Signer) (types.PublicKeys, error) {
+	digest, err := p.Digest()
+	if err != nil {
+		return nil, errors.Annotate(err, "Digest")
+	}
+
+	missing, err := p.MissingKeys()
+	if err != nil {
+		return nil, errors.Annotate(err, "MissingKeys")
+	}
+
+	signed := types.PublicKeys{}
+	for _, pub := range SignerKeys(signer, missing) {
+		sig, err := signer.SignCompact(&pub, digest)
+		if err != nil {
+			return nil, errors.Annotatef(err, "SignCompact [%s]", pub)
+		}
+
+		//the transaction can't be adjusted without invalidating the other signatures
+		if !isCanonical(sig) {
+			return nil, errors.Annotate(ErrNonCanonicalSignature, pub.String())
+		}
+
+		p.Transaction.Signatures = append(p.Transaction.Signatures, types.Buffer(sig))
+		signed = append(signed, pub)
+	}
+
+	return signed, nil
+}
+
+//AddSignatures validates sigs against the digest and adds those of keys that didn't sign yet.
+func (p *SignatureEnvelope) AddSignatures(sigs ...types.Buffer) error {
+	digest, err := p.Digest()
+	if err != nil {
+		return errors.Annotate(err, "Digest")
+	}
+
+	signed, err := p.SignedKeys()
+	if err != nil {
+		return errors.Annotate(err, "SignedKeys")
+	}
+
+	for _, sig := range sigs {
+		if len(sig) != 65 || !isCanonical(sig) {
+			return ErrNonCanonicalSignature
+		}
+
+		key, _, err := btcec.RecoverCompact(btcec.S256(), sig, digest)
+		if err != nil {
+			return errors.Annotate(err, "RecoverCompact")
+		}
+
+		pub, err := types.NewPublicKey(key)
+		if err != nil {
+			return errors.Annotate(err, "NewPublicKey")
+		}
+
+		if !containsKey(p.RequiredKeys, pub) {
+			return errors.Annotate(ErrUnexpectedSignature, pub.String())
+		}
+
+		if containsKey(signed, pub) {
+			continue
+		}
+
+		p.Transaction.Signatures = append(p.Transaction.Signatures, sig)
+		signed = append(signed, *pub)
+	}
+
+	return nil
+}
+
+//Merge adds the signatures of other, which must hold the same transaction.
+func (p *SignatureEnvelope) Merge(other *SignatureEnvelope) error {
+	if p.ChainID != other.ChainID {
+		return ErrEnvelopeMismatch
+	}
+
+	dig1, err := p.Digest()
+	if err != nil {
+		return errors.Annotate(err, "Digest")
+	}
+
+	dig2, err := other.Digest()
+	if err != nil {
+		return errors.Annotate(err, "Digest [other]")
+	}
+
+	if !bytes.Equal(dig1, dig2) {
+		return ErrEnvelopeMismatch
+	}
+
+	return p.AddSignatures(other.Transaction.Signatures...)
+}
+
+//SignedKeys returns the keys of the present signatures.
+func (p *SignatureEnvelope) SignedKeys() (types.PublicKeys, error) {
+	chain, err := p.Chain()
+	if err != nil {
+		return nil, errors.Annotate(err, "Chain")
+	}
+
+	return SignatureKeys(p.Transaction, chain)
+}
+
+//MissingKeys returns the required keys that didn't sign yet.
+//Keys are listed regardless of whether the thresholds are met already.
+func (p *SignatureEnvelope) MissingKeys() (types.PublicKeys, error) {
+	signed, err := p.SignedKeys()
+	if err != nil {
+		return nil, errors.Annotate(err, "SignedKeys")
+	}
+
+	ret := types.PublicKeys{}
+	for idx := range p.RequiredKeys {
+		if !containsKey(signed, &p.RequiredKeys[idx]) {
+			ret = append(ret, p.RequiredKeys[idx])
+		}
+	}
+
+	return ret, nil
+}
+
+//Check evaluates the present signatures against the authorities fetched by checker.
+//The report is satisfied if the thresholds are met and no signature is unused, even if required
+//keys are missing. Use Prune to drop signatures beyond the thresholds.
+func (p *SignatureEnvelope) Check(checker *AuthorityChecker) (*AuthorityReport, error) {
+	chain, err := p.Chain()
+	if err != nil {
+		return nil, errors.Annotate(err, "Chain")
+	}
+
+	return checker.CheckSignatures(p.Transaction, chain)
+}
+
+//Prune drops the signatures Check reports unused, which the node refuses as irrelevant,
+//and returns the report of the remaining signatures.
+func (p *SignatureEnvelope) Prune(checker *AuthorityChecker) (*AuthorityReport, error) {
+	signed, err := p.SignedKeys()
+	if err != nil {
+		return nil, errors.Annotate(err, "SignedKeys")
+	}
+
+	report, err := p.Check(checker)
+	if err != nil {
+		return nil, errors.Annotate(err, "Check")
+	}
+
+	if len(report.Unused) == 0 {
+		return report, nil
+	}
+
+	sigs := types.Signatures{}
+	for idx, sig := range p.Transaction.Signatures {
+		if !containsKey(report.Unused, &signed[idx]) {
+			sigs = append(sigs, sig)
+		}
+	}
+
+	p.Transaction.Signatures = sigs
+	return p.Check(checker)
+}
+
+//MarshalHex returns the hex encoded JSON of the envelope.
+func (p *SignatureEnvelope) MarshalHex() (string, error) {
+	data, err := ffjson.Marshal(p)
+	if err != nil {
+		return "", errors.Annotate(err, "Marshal")
+	}
+
+	return hex.EncodeToString(data), nil
+}
+
+//Save writes the envelope as JSON to path.
+func (p *SignatureEnvelope) Save(path string) error {
+	data, err := ffjson.Marshal(p)
+	if err != nil {
+		return errors.Annotate(err, "Marshal")
+	}
+
+	if err := ioutil.WriteFile(path, data, 0600); err != nil {
+		return errors.Annotate(err, "WriteFile")
+	}
+
+	return nil
+}
+
+func containsKey(keys types.PublicKeys, pub *types.PublicKey) bool {
+	for idx := range keys {
+		if keys[idx].Equal(pub) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,169 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/denkhaus/bitshares/config"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignCanonical(t *testing.T) {
+	config.SetCurrent(config.ChainIDGPH)
+
+	priv, err := types.GeneratePrivateKey()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	digest := make([]byte, 32)
+	for i := 0; i < 32; i++ {
+		digest[0] = byte(i)
+		sig, err := signCanonical(priv, digest)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.True(t, isCanonical(sig))
+
+		plain, err := priv.SignCompact(digest)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		countered, err := signCompactWithCounter(priv, digest, 1)
+		if assert.NoError(t, err) {
+			assert.NotEqual(t, plain, countered)
+			pub, _, err := btcec.RecoverCompact(btcec.S256(), countered, digest)
+			if assert.NoError(t, err) {
+				assert.Equal(t, priv.PublicKey().Bytes(), pub.SerializeCompressed())
+			}
+		}
+	}
+}
+
+func TestSignatureEnvelope(t *testing.T) {
+	config.SetCurrent(config.ChainIDGPH)
+
+	bags := make([]*KeyBag, 3)
+	for idx := range bags {
+		priv, err := types.GeneratePrivateKey()
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		bags[idx] = NewKeyBag()
+		assert.NoError(t, bags[idx].Add(priv.ToWIF()))
+	}
+
+	active := keyAuthority(2)
+	required := types.PublicKeys{}
+	for _, bag := range bags {
+		pub := bag.Publics()[0]
+		active.KeyAuths[&pub] = 1
+		required = append(required, pub)
+	}
+
+	checker := NewAuthorityChecker(AccountsLookup(types.Accounts{
+		{ID: accountID("1.2.100"), Active: active, Owner: keyAuthority(1)},
+	}))
+
+	env := NewSignatureEnvelope(transferFrom("1.2.100"), config.Current(), required)
+	data, err := env.MarshalHex()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// two parties sign their own copy
+	copies := make([]*SignatureEnvelope, 2)
+	for idx := range copies {
+		copies[idx], err = ReadSignatureEnvelope([]byte(data))
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		signed, err := copies[idx].Sign(bags[idx])
+		if assert.NoError(t, err) {
+			assert.Equal(t, bags[idx].Publics(), signed)
+		}
+
+		signed, err = copies[idx].Sign(bags[idx])
+		if assert.NoError(t, err) {
+			assert.Empty(t, signed)
+		}
+	}
+
+	report, err := copies[0].Check(checker)
+	if assert.NoError(t, err) {
+		assert.False(t, report.Satisfied)
+	}
+
+	assert.NoError(t, env.Merge(copies[0]))
+	assert.NoError(t, env.Merge(copies[1]))
+	assert.NoError(t, env.Merge(copies[1]))
+	assert.Len(t, env.Transaction.Signatures, 2)
+
+	missing, err := env.MissingKeys()
+	if assert.NoError(t, err) {
+		assert.Equal(t, bags[2].Publics(), missing)
+	}
+
+	report, err = env.Check(checker)
+	if assert.NoError(t, err) {
+		assert.True(t, report.Satisfied)
+	}
+
+	// a roundtrip keeps the signatures valid
+	data, err = env.MarshalHex()
+	if assert.NoError(t, err) {
+		restored, err := ReadSignatureEnvelope([]byte(data))
+		if assert.NoError(t, err) {
+			assert.Len(t, restored.Transaction.Signatures, 2)
+		}
+	}
+
+	// a signature beyond the threshold is unused and pruned
+	over, err := ReadSignatureEnvelope([]byte(data))
+	if assert.NoError(t, err) {
+		signed, err := over.Sign(bags[2])
+		if assert.NoError(t, err) {
+			assert.Equal(t, bags[2].Publics(), signed)
+		}
+
+		report, err := over.Check(checker)
+		if assert.NoError(t, err) {
+			assert.False(t, report.Satisfied)
+			assert.Len(t, report.Used, 2)
+			assert.Len(t, report.Unused, 1)
+		}
+
+		report, err = over.Prune(checker)
+		if assert.NoError(t, err) {
+			assert.True(t, report.Satisfied)
+			assert.Empty(t, report.Unused)
+			assert.Len(t, over.Transaction.Signatures, 2)
+		}
+
+		report, err = env.Prune(checker)
+		if assert.NoError(t, err) {
+			assert.True(t, report.Satisfied)
+			assert.Len(t, env.Transaction.Signatures, 2)
+		}
+	}
+
+	// envelopes of other transactions don't merge
+	other := NewSignatureEnvelope(transferFrom("1.2.200"), config.Current(), required)
+	assert.Equal(t, ErrEnvelopeMismatch, env.Merge(other))
+
+	// signatures of keys not required are rejected
+	stranger, err := types.GeneratePrivateKey()
+	if assert.NoError(t, err) {
+		digest, err := env.Digest()
+		if assert.NoError(t, err) {
+			sig, err := signCanonical(stranger, digest)
+			if assert.NoError(t, err) {
+				assert.Error(t, env.AddSignatures(sig))
+			}
+		}
+	}
+}
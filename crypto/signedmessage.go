@@ -0,0 +1,180 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
+)
+
+const (
+	SignedMessageHead      = "-----BEGIN BITSHARES SIGNED MESSAGE-----"
+	SignedMessageMeta      = "-----BEGIN META-----"
+	SignedMessageSignature = "-----BEGIN SIGNATURE-----"
+	SignedMessageFoot      = "-----END BITSHARES SIGNED MESSAGE-----"
+
+	//SignedMessageTimeFormat is the format of the timestamp meta field.
+	SignedMessageTimeFormat = "2006-01-02T15:04:05"
+)
+
+var (
+	ErrInvalidSignedMessage = errors.New("invalid signed message")
+	ErrMessageKeyMismatch   = errors.New("message not signed by memo key")
+)
+
+//SignedMessage is a message signed with an account's memo key in the reference UI format.
+type SignedMessage struct {
+	Message   string
+	Account   string
+	MemoKey   types.PublicKey
+	Block     types.UInt32
+	Timestamp string
+	Signature types.Buffer
+}
+
+//NewSignedMessage signs message with the memo key of account held by signer.
+//block and timestamp should describe the head block at the time of signing.
+func NewSignedMessage(signer Signer, account string, memoKey types.PublicKey, block types.UInt32, timestamp time.Time, message string) (*SignedMessage, error) {
+	msg := SignedMessage{
+		Message:   message,
+		Account:   account,
+		MemoKey:   memoKey,
+		Block:     block,
+		Timestamp: timestamp.UTC().Format(SignedMessageTimeFormat),
+	}
+
+	sig, err := signer.SignCompact(&memoKey, msg.Digest())
+	if err != nil {
+		return nil, errors.Annotate(err, "SignCompact")
+	}
+
+	msg.Signature = sig
+	return &msg, nil
+}
+
+//ParseSignedMessage parses the armored text of a SignedMessage.
+func ParseSignedMessage(text string) (*SignedMessage, error) {
+	text = strings.TrimSpace(strings.Replace(text, "\r\n", "\n", -1))
+	if !strings.HasPrefix(text, SignedMessageHead+"\n") || !strings.HasSuffix(text, "\n"+SignedMessageFoot) {
+		return nil, ErrInvalidSignedMessage
+	}
+
+	body := text[len(SignedMessageHead)+1 : len(text)-len(SignedMessageFoot)-1]
+	metaIdx := strings.LastIndex("\n"+body, "\n"+SignedMessageMeta+"\n")
+	sigIdx := strings.LastIndex(body, "\n"+SignedMessageSignature+"\n")
+	if metaIdx < 0 || sigIdx < metaIdx {
+		return nil, ErrInvalidSignedMessage
+	}
+
+	meta := body[metaIdx+len(SignedMessageMeta)+1 : sigIdx]
+	msg := SignedMessage{}
+	if metaIdx > 0 {
+		msg.Message = body[:metaIdx-1]
+	}
+
+	for _, line := range strings.Split(meta, "\n") {
+		kv := strings.SplitN(line, "=", 2)
+		if len(kv) != 2 {
+			return nil, ErrInvalidSignedMessage
+		}
+
+		switch kv[0] {
+		case "account":
+			msg.Account = kv[1]
+		case "memokey":
+			pub, err := types.NewPublicKeyFromString(kv[1])
+			if err != nil {
+				return nil, errors.Annotate(err, "NewPublicKeyFromString")
+			}
+			msg.MemoKey = *pub
+		case "block":
+			block, err := strconv.ParseUint(kv[1], 10, 32)
+			if err != nil {
+				return nil, errors.Annotate(err, "ParseUint [block]")
+			}
+			msg.Block = types.UInt32(block)
+		case "timestamp":
+			msg.Timestamp = kv[1]
+		default:
+			return nil, errors.Annotatef(ErrInvalidSignedMessage, "unknown meta %q", kv[0])
+		}
+	}
+
+	//the signature covers the meta text, so it has to be reproducible
+	if msg.meta() != meta {
+		return nil, errors.Annotate(ErrInvalidSignedMessage, "meta")
+	}
+
+	sig, err := hex.DecodeString(strings.TrimSpace(body[sigIdx+len(SignedMessageSignature)+2:]))
+	if err != nil {
+		return nil, errors.Annotate(err, "DecodeString [signature]")
+	}
+
+	msg.Signature = sig
+	return &msg, nil
+}
+
+func (p SignedMessage) meta() string {
+	return fmt.Sprintf("account=%s\nmemokey=%s\nblock=%d\ntimestamp=%s",
+		p.Account, p.MemoKey, p.Block, p.Timestamp)
+}
+
+//Payload returns the signed content, the message followed by the meta fields.
+func (p SignedMessage) Payload() string {
+	return p.Message + "\n" + p.meta()
+}
+
+//Digest returns the sha256 hash of the payload.
+func (p SignedMessage) Digest() []byte {
+	digest := sha256.Sum256([]byte(p.Payload()))
+	return digest[:]
+}
+
+//Recover returns the public key that created the signature.
+func (p SignedMessage) Recover() (*types.PublicKey, error) {
+	key, _, err := btcec.RecoverCompact(btcec.S256(), p.Signature, p.Digest())
+	if err != nil {
+		return nil, errors.Annotate(err, "RecoverCompact")
+	}
+
+	pub, err := types.NewPublicKey(key)
+	if err != nil {
+		return nil, errors.Annotate(err, "NewPublicKey")
+	}
+
+	return pub, nil
+}
+
+//Verify checks that the signature was created by MemoKey.
+//Whether MemoKey is the account's memo key has to be checked against the chain.
+func (p SignedMessage) Verify() error {
+	pub, err := p.Recover()
+	if err != nil {
+		return errors.Annotate(err, "Recover")
+	}
+
+	if !pub.Equal(&p.MemoKey) {
+		return ErrMessageKeyMismatch
+	}
+
+	return nil
+}
+
+//String returns the armored text of the message.
+func (p SignedMessage) String() string {
+	return strings.Join([]string{
+		SignedMessageHead,
+		p.Message,
+		SignedMessageMeta,
+		p.meta(),
+		SignedMessageSignature,
+		hex.EncodeToString(p.Signature),
+		SignedMessageFoot,
+	}, "\n")
+}
@@ -0,0 +1,51 @@
+package crypto
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/denkhaus/bitshares/config"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignedMessage(t *testing.T) {
+	config.SetCurrent(config.ChainIDGPH)
+
+	priv, err := types.GeneratePrivateKey()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	bag := NewKeyBag()
+	assert.NoError(t, bag.Add(priv.ToWIF()))
+
+	tm := time.Date(2019, 5, 1, 10, 30, 0, 0, time.UTC)
+	for _, message := range []string{"I own this account", "multiple\nlines\n", ""} {
+		msg, err := NewSignedMessage(bag, "alice", *priv.PublicKey(), 12345, tm, message)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		text := msg.String()
+		assert.True(t, strings.HasPrefix(text, SignedMessageHead+"\n"+message+"\n"+SignedMessageMeta))
+		assert.Contains(t, text, "\naccount=alice\nmemokey="+priv.PublicKey().String()+"\nblock=12345\ntimestamp=2019-05-01T10:30:00\n")
+
+		parsed, err := ParseSignedMessage(text)
+		if assert.NoError(t, err) {
+			assert.Equal(t, message, parsed.Message)
+			assert.Equal(t, "alice", parsed.Account)
+			assert.Equal(t, types.UInt32(12345), parsed.Block)
+			assert.NoError(t, parsed.Verify())
+		}
+
+		tampered, err := ParseSignedMessage(strings.Replace(text, "account=alice", "account=bob", 1))
+		if assert.NoError(t, err) {
+			assert.Error(t, tampered.Verify())
+		}
+	}
+
+	_, err = ParseSignedMessage("not a signed message")
+	assert.Equal(t, ErrInvalidSignedMessage, err)
+}
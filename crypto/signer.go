@@ -0,0 +1,69 @@
+package crypto
+
+import (
+	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
+)
+
+//Signer signs with private keys it does not have to expose.
+//KeyBag is the in-process implementation.
+type Signer interface {
+	//Publics returns the public keys of all keys the Signer can use.
+	Publics() types.PublicKeys
+	//SignCompact signs digest with the private key of pub and returns a compact, recoverable signature.
+	SignCompact(pub *types.PublicKey, digest []byte) ([]byte, error)
+	//SharedSecret returns the ECDH shared secret of the private key of pub and counterparty.
+	SharedSecret(pub, counterparty *types.PublicKey, skLen, macLen int) ([]byte, error)
+}
+
+//SignerKeys returns the keys of pubs signer can sign with.
+func SignerKeys(signer Signer, pubs types.PublicKeys) (out types.PublicKeys) {
+	available := signer.Publics()
+	for _, pub := range pubs {
+		for _, key := range available {
+			if key.Equal(&pub) {
+				out = append(out, pub)
+				break
+			}
+		}
+	}
+
+	return
+}
+
+//EncryptMemo encrypts msg into memo with the key of memo.From held by signer.
+func EncryptMemo(signer Signer, memo *types.Memo, msg string) error {
+	sec, err := signer.SharedSecret(&memo.From, &memo.To, 16, 16)
+	if err != nil {
+		return errors.Annotate(err, "SharedSecret")
+	}
+
+	if err := memo.EncryptWithSecret(sec, msg); err != nil {
+		return errors.Annotate(err, "EncryptWithSecret")
+	}
+
+	return nil
+}
+
+//DecryptMemo decrypts memo with the key of either memo.To or memo.From held by signer.
+func DecryptMemo(signer Signer, memo *types.Memo) (string, error) {
+	pub, counterparty := &memo.To, &memo.From
+	if len(SignerKeys(signer, types.PublicKeys{memo.To})) == 0 {
+		pub, counterparty = &memo.From, &memo.To
+		if len(SignerKeys(signer, types.PublicKeys{memo.From})) == 0 {
+			return "", ErrKeyNotFound
+		}
+	}
+
+	sec, err := signer.SharedSecret(pub, counterparty, 16, 16)
+	if err != nil {
+		return "", errors.Annotate(err, "SharedSecret")
+	}
+
+	msg, err := memo.DecryptWithSecret(sec)
+	if err != nil {
+		return "", errors.Annotate(err, "DecryptWithSecret")
+	}
+
+	return msg, nil
+}
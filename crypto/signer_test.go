@@ -0,0 +1,145 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/denkhaus/bitshares/config"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+//countingSigner wraps a KeyBag like an out-of-process signer would.
+type countingSigner struct {
+	bag   *KeyBag
+	signs int
+}
+
+func (p *countingSigner) Publics() types.PublicKeys {
+	return p.bag.Publics()
+}
+
+func (p *countingSigner) SignCompact(pub *types.PublicKey, digest []byte) ([]byte, error) {
+	p.signs++
+	return p.bag.SignCompact(pub, digest)
+}
+
+func (p *countingSigner) SharedSecret(pub, counterparty *types.PublicKey, skLen, macLen int) ([]byte, error) {
+	return p.bag.SharedSecret(pub, counterparty, skLen, macLen)
+}
+
+func TestSignerSignsTransaction(t *testing.T) {
+	config.SetCurrent(config.ChainIDGPH)
+
+	bag := NewKeyBag()
+	for i := 0; i < 2; i++ {
+		priv, err := types.GeneratePrivateKey()
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.NoError(t, bag.Add(priv.ToWIF()))
+	}
+
+	other, err := types.GeneratePrivateKey()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	signer := &countingSigner{bag: bag}
+	required := append(bag.Publics(), *other.PublicKey())
+	pubs := SignerKeys(signer, required)
+	assert.Len(t, pubs, 2)
+
+	tx := types.NewSignedTransaction()
+	if !assert.NoError(t, NewTransactionSigner(tx).SignWith(signer, pubs, config.Current())) {
+		return
+	}
+
+	assert.Len(t, tx.Signatures, 2)
+	assert.True(t, signer.signs >= 2)
+
+	verified, err := VerifySignedTransaction(bag, tx)
+	if assert.NoError(t, err) {
+		assert.True(t, verified)
+	}
+
+	_, err = bag.SignCompact(other.PublicKey(), make([]byte, 32))
+	assert.Equal(t, ErrKeyNotFound, err)
+}
+
+//nonCanonicalSigner returns signatures that are never canonical.
+type nonCanonicalSigner struct {
+	countingSigner
+}
+
+func (p *nonCanonicalSigner) SignCompact(pub *types.PublicKey, digest []byte) ([]byte, error) {
+	p.signs++
+	sig := make([]byte, 65)
+	sig[1] = 0x80
+	return sig, nil
+}
+
+func TestSignerNonCanonical(t *testing.T) {
+	config.SetCurrent(config.ChainIDGPH)
+
+	bag := NewKeyBag()
+	priv, err := types.GeneratePrivateKey()
+	if !assert.NoError(t, err) || !assert.NoError(t, bag.Add(priv.ToWIF())) {
+		return
+	}
+
+	signer := &nonCanonicalSigner{countingSigner{bag: bag}}
+
+	//the expiration of a signed transaction must not change
+	tx := types.NewSignedTransaction()
+	tx.Signatures = append(tx.Signatures, types.Buffer(make([]byte, 65)))
+	expiration := tx.Expiration
+
+	err = NewTransactionSigner(tx).SignWith(signer, bag.Publics(), config.Current())
+	assert.Equal(t, ErrNonCanonicalSignature, errors.Cause(err))
+	assert.Equal(t, expiration, tx.Expiration)
+	assert.Len(t, tx.Signatures, 1)
+	assert.Equal(t, 1, signer.signs)
+
+	//retries are bounded
+	tx = types.NewSignedTransaction()
+	err = NewTransactionSigner(tx).SignWith(signer, bag.Publics(), config.Current())
+	assert.Equal(t, ErrNonCanonicalSignature, errors.Cause(err))
+	assert.Empty(t, tx.Signatures)
+	assert.Equal(t, 1+maxSignAttempts, signer.signs)
+}
+
+func TestSignerEncryptsMemo(t *testing.T) {
+	config.SetCurrent(config.ChainIDGPH)
+
+	from, err := types.GeneratePrivateKey()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	to, err := types.GeneratePrivateKey()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	bag := NewKeyBag()
+	assert.NoError(t, bag.Add(from.ToWIF()))
+
+	memo := types.Memo{
+		From:  *from.PublicKey(),
+		To:    *to.PublicKey(),
+		Nonce: 12345,
+	}
+
+	if !assert.NoError(t, EncryptMemo(&countingSigner{bag: bag}, &memo, "hello")) {
+		return
+	}
+
+	msg, err := memo.Decrypt(to)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "hello", msg)
+	}
+
+	memo.From = *to.PublicKey()
+	assert.Error(t, EncryptMemo(bag, &memo, "hello"))
+}
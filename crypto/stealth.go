@@ -0,0 +1,402 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"math/big"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/denkhaus/bitshares/operations"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/denkhaus/bitshares/util"
+	"github.com/juju/errors"
+)
+
+var (
+	ErrInvalidBlindingFactor = errors.New("invalid blinding factor")
+	ErrCommitmentMismatch    = errors.New("commitment does not match stealth memo")
+	ErrAssetMismatch         = errors.New("blinded balances of different assets")
+	ErrInsufficientBlinded   = errors.New("blinded balances do not cover the fee")
+	ErrInvalidStealthMemo    = errors.New("invalid stealth memo")
+)
+
+//pedersenH is the second generator of secp256k1-zkp used for the value part of commitments.
+var pedersenH = struct{ X, Y *big.Int }{
+	X: fromHex("50929b74c1a04954b78b4b6035e97a5e078a5a0f28ec96d547bfee9ace803ac0"),
+	Y: fromHex("31d3c6863973926e049e637cb1b5f40a36dac28af1766968c30c2313f3a38904"),
+}
+
+func fromHex(s string) *big.Int {
+	i, _ := new(big.Int).SetString(s, 16)
+	return i
+}
+
+//BlindCommit returns the Pedersen commitment blind*G + value*H in the 33 byte
+//compressed point form of the secp256k1-zkp version graphene is built with.
+func BlindCommit(blind []byte, value uint64) ([]byte, error) {
+	curve := btcec.S256()
+	if len(blind) != 32 || new(big.Int).SetBytes(blind).Cmp(curve.N) >= 0 {
+		return nil, ErrInvalidBlindingFactor
+	}
+
+	var v [8]byte
+	binary.BigEndian.PutUint64(v[:], value)
+
+	bx, by := curve.ScalarBaseMult(blind)
+	vx, vy := curve.ScalarMult(pedersenH.X, pedersenH.Y, v[:])
+	x, y := curve.Add(bx, by, vx, vy)
+	if x.Sign() == 0 && y.Sign() == 0 {
+		return nil, ErrInvalidBlindingFactor
+	}
+
+	return (&btcec.PublicKey{Curve: curve, X: x, Y: y}).SerializeCompressed(), nil
+}
+
+//BlindSum returns the sum of the positive minus the sum of the negative blinding factors.
+func BlindSum(positive, negative [][]byte) []byte {
+	n := btcec.S256().N
+	sum := new(big.Int)
+	for _, blind := range positive {
+		sum.Add(sum, new(big.Int).SetBytes(blind))
+	}
+	for _, blind := range negative {
+		sum.Sub(sum, new(big.Int).SetBytes(blind))
+	}
+
+	return sum.Mod(sum, n).FillBytes(make([]byte, 32))
+}
+
+//childOffset is the tweak fc adds to derive the child of pub by offset.
+func childOffset(pub *types.PublicKey, offset []byte) *big.Int {
+	h := sha256.Sum256(append(pub.Bytes(), offset...))
+	return new(big.Int).SetBytes(h[:])
+}
+
+//ChildPublicKey derives the public key graphene's public_key::child(offset) returns.
+func ChildPublicKey(pub *types.PublicKey, offset []byte) (*types.PublicKey, error) {
+	curve := btcec.S256()
+	ecpub := pub.ToECDSA()
+
+	tx, ty := curve.ScalarBaseMult(childOffset(pub, offset).Bytes())
+	x, y := curve.Add(ecpub.X, ecpub.Y, tx, ty)
+
+	child, err := types.NewPublicKey(&btcec.PublicKey{Curve: curve, X: x, Y: y})
+	if err != nil {
+		return nil, errors.Annotate(err, "NewPublicKey")
+	}
+
+	return child, nil
+}
+
+//ChildPrivateKey derives the private key of ChildPublicKey(priv.PublicKey(), offset).
+func ChildPrivateKey(priv *types.PrivateKey, offset []byte) (*types.PrivateKey, error) {
+	n := btcec.S256().N
+	d := new(big.Int).Add(priv.ECPrivateKey().D, childOffset(priv.PublicKey(), offset))
+	d.Mod(d, n)
+
+	child, err := types.NewPrivateKeyFromSecret(d.FillBytes(make([]byte, 32)))
+	if err != nil {
+		return nil, errors.Annotate(err, "NewPrivateKeyFromSecret")
+	}
+
+	return child, nil
+}
+
+//StealthMemo is the decrypted content of a stealth confirmation.
+type StealthMemo struct {
+	From           *types.PublicKey
+	Amount         types.AssetAmount
+	BlindingFactor types.FixedBuffer
+	Commitment     types.FixedBuffer
+	Check          types.UInt32
+}
+
+func (p StealthMemo) Marshal(enc *util.TypeEncoder) error {
+	if err := enc.Encode(p.From != nil); err != nil {
+		return errors.Annotate(err, "encode has From")
+	}
+	if err := enc.Encode(p.From); err != nil {
+		return errors.Annotate(err, "encode From")
+	}
+	if err := enc.Encode(p.Amount); err != nil {
+		return errors.Annotate(err, "encode Amount")
+	}
+	if err := enc.Encode(p.BlindingFactor); err != nil {
+		return errors.Annotate(err, "encode BlindingFactor")
+	}
+	if err := enc.Encode(p.Commitment); err != nil {
+		return errors.Annotate(err, "encode Commitment")
+	}
+	if err := enc.Encode(p.Check); err != nil {
+		return errors.Annotate(err, "encode Check")
+	}
+
+	return nil
+}
+
+func (p *StealthMemo) unpack(data []byte) error {
+	const keyLen = btcec.PubKeyBytesLenCompressed
+	if len(data) < 1 {
+		return ErrInvalidStealthMemo
+	}
+
+	if data[0] == 1 {
+		if len(data) < 1+keyLen {
+			return ErrInvalidStealthMemo
+		}
+
+		key, err := btcec.ParsePubKey(data[1:1+keyLen], btcec.S256())
+		if err != nil {
+			return errors.Annotate(err, "ParsePubKey")
+		}
+
+		if p.From, err = types.NewPublicKey(key); err != nil {
+			return errors.Annotate(err, "NewPublicKey")
+		}
+
+		data = data[keyLen:]
+	}
+
+	data = data[1:]
+	if len(data) < 8 {
+		return ErrInvalidStealthMemo
+	}
+
+	p.Amount.Amount = types.Int64(binary.LittleEndian.Uint64(data))
+	rd := bytes.NewReader(data[8:])
+	if err := p.Amount.Asset.Unmarshal(util.NewTypeDecoder(rd)); err != nil {
+		return errors.Annotate(err, "decode Asset")
+	}
+
+	data = data[len(data)-rd.Len():]
+	if len(data) != 32+keyLen+4 {
+		return ErrInvalidStealthMemo
+	}
+
+	p.BlindingFactor = types.FixedBuffer{Buffer: types.Buffer(data[:32])}
+	p.Commitment = types.FixedBuffer{Buffer: types.Buffer(data[32 : 32+keyLen])}
+	p.Check = types.UInt32(binary.LittleEndian.Uint32(data[32+keyLen:]))
+	return nil
+}
+
+//BlindReceipt describes a blinded balance sent to a stealth address.
+type BlindReceipt struct {
+	//Confirmation is what the recipient needs to take possession of the balance.
+	Confirmation types.StealthConfirmation
+	Memo         StealthMemo
+	//OwnerKey is the one-time child key owning the commitment.
+	OwnerKey types.PublicKey
+}
+
+type BlindReceipts []BlindReceipt
+
+//Input returns the receipt as input of a transfer from blind.
+func (p BlindReceipt) Input() types.BlindInput {
+	return types.BlindInput{
+		Commitment: p.Memo.Commitment,
+		Owner:      ownerAuthority(&p.OwnerKey),
+	}
+}
+
+func ownerAuthority(pub *types.PublicKey) types.Authority {
+	return types.Authority{
+		WeightThreshold: 1,
+		AccountAuths:    types.AccountAuthsMap{},
+		KeyAuths:        types.KeyAuthsMap{pub: 1},
+		AddressAuths:    types.AddressAuthsMap{},
+	}
+}
+
+//stealthSecret derives the confirmation secret like fc's get_shared_secret, sha512 of the ECDH x coordinate.
+func stealthSecret(priv *types.PrivateKey, pub *types.PublicKey) ([]byte, error) {
+	x, err := priv.SharedSecret(pub, 16, 16)
+	if err != nil {
+		return nil, errors.Annotate(err, "SharedSecret")
+	}
+
+	sec := sha512.Sum512(x)
+	return sec[:], nil
+}
+
+//NewStealthOutput creates a blinded output of amount owned by a one-time child key of to.
+//from is optional and only revealed to the recipient.
+func NewStealthOutput(to types.PublicKey, from *types.PublicKey, amount types.AssetAmount) (*types.BlindOutput, *BlindReceipt, error) {
+	oneTimeKey, err := types.GeneratePrivateKey()
+	if err != nil {
+		return nil, nil, errors.Annotate(err, "GeneratePrivateKey")
+	}
+
+	secret, err := stealthSecret(oneTimeKey, &to)
+	if err != nil {
+		return nil, nil, errors.Annotate(err, "stealthSecret")
+	}
+
+	child := sha256.Sum256(secret)
+	blind := sha256.Sum256(child[:])
+
+	owner, err := ChildPublicKey(&to, child[:])
+	if err != nil {
+		return nil, nil, errors.Annotate(err, "ChildPublicKey")
+	}
+
+	commitment, err := BlindCommit(blind[:], uint64(amount.Amount))
+	if err != nil {
+		return nil, nil, errors.Annotate(err, "BlindCommit")
+	}
+
+	memo := StealthMemo{
+		From:           from,
+		Amount:         amount,
+		BlindingFactor: types.FixedBuffer{Buffer: types.Buffer(blind[:])},
+		Commitment:     types.FixedBuffer{Buffer: types.Buffer(commitment)},
+		Check:          types.UInt32(binary.LittleEndian.Uint32(secret)),
+	}
+
+	var buf bytes.Buffer
+	if err := util.NewTypeEncoder(&buf).Encode(memo); err != nil {
+		return nil, nil, errors.Annotate(err, "encode StealthMemo")
+	}
+
+	encrypted, err := aesEncrypt(secret, buf.Bytes())
+	if err != nil {
+		return nil, nil, errors.Annotate(err, "aesEncrypt")
+	}
+
+	receipt := BlindReceipt{
+		Confirmation: types.StealthConfirmation{
+			OneTimeKey:    *oneTimeKey.PublicKey(),
+			To:            &to,
+			EncryptedMemo: encrypted,
+		},
+		Memo:     memo,
+		OwnerKey: *owner,
+	}
+
+	out := types.BlindOutput{
+		Commitment:          memo.Commitment,
+		Owner:               ownerAuthority(owner),
+		RangeProof:          types.Buffer{},
+		StealthConfirmation: &receipt.Confirmation,
+	}
+
+	return &out, &receipt, nil
+}
+
+//NewTransferToBlindOperation blinds amount from the account from to the stealth key to.
+//Without range proofs graphene only accepts a single output per operation,
+//so sending to several recipients takes one operation each.
+func NewTransferToBlindOperation(from types.AccountID, to types.PublicKey, amount types.AssetAmount) (*operations.TransferToBlindOperation, *BlindReceipt, error) {
+	out, receipt, err := NewStealthOutput(to, nil, amount)
+	if err != nil {
+		return nil, nil, errors.Annotate(err, "NewStealthOutput")
+	}
+
+	op := operations.TransferToBlindOperation{
+		Amount:         amount,
+		BlindingFactor: types.FixedBuffer{Buffer: types.Buffer(BlindSum([][]byte{receipt.Memo.BlindingFactor.Bytes()}, nil))},
+		From:           from,
+		Outputs:        types.BlindOutputs{*out},
+	}
+
+	return &op, receipt, nil
+}
+
+//NewTransferFromBlindOperation spends receipts in full to the account to, paying fee out of them.
+//Receipts must be of the same asset as fee, partial spends need range proofs and are not supported.
+func NewTransferFromBlindOperation(to types.AccountID, fee types.AssetAmount, receipts ...BlindReceipt) (*operations.TransferFromBlindOperation, error) {
+	if len(receipts) == 0 {
+		return nil, errors.New("no blinded balances to transfer")
+	}
+
+	total := types.Int64(0)
+	blinds := make([][]byte, 0, len(receipts))
+	inputs := make(types.BlindInputs, 0, len(receipts))
+	for _, receipt := range receipts {
+		if receipt.Memo.Amount.Asset.ID() != fee.Asset.ID() {
+			return nil, ErrAssetMismatch
+		}
+
+		total += receipt.Memo.Amount.Amount
+		blinds = append(blinds, receipt.Memo.BlindingFactor.Bytes())
+		inputs = append(inputs, receipt.Input())
+	}
+
+	if total <= fee.Amount {
+		return nil, ErrInsufficientBlinded
+	}
+
+	//graphene requires inputs sorted by commitment
+	sort.Slice(inputs, func(i, j int) bool {
+		return bytes.Compare(inputs[i].Commitment.Bytes(), inputs[j].Commitment.Bytes()) < 0
+	})
+
+	op := operations.TransferFromBlindOperation{
+		Amount: types.AssetAmount{
+			Amount: total - fee.Amount,
+			Asset:  fee.Asset,
+		},
+		To:          to,
+		BlindFactor: types.FixedBuffer{Buffer: types.Buffer(BlindSum(blinds, nil))},
+		BlindInputs: inputs,
+	}
+
+	op.SetFee(fee)
+	return &op, nil
+}
+
+//ReceiveStealthConfirmation decrypts conf with the key of conf.To, verifies the commitment
+//and adds the one-time child key to the bag, so the blinded balance can be spent.
+func (b *KeyBag) ReceiveStealthConfirmation(conf *types.StealthConfirmation) (*BlindReceipt, error) {
+	if conf.To == nil {
+		return nil, errors.Annotate(types.ErrInvalidStealthConfirmation, "no recipient key")
+	}
+
+	priv := b.private(conf.To)
+	if priv == nil {
+		return nil, ErrKeyNotFound
+	}
+
+	secret, err := stealthSecret(priv, &conf.OneTimeKey)
+	if err != nil {
+		return nil, errors.Annotate(err, "stealthSecret")
+	}
+
+	plain, err := aesDecrypt(secret, conf.EncryptedMemo)
+	if err != nil {
+		return nil, errors.Annotate(err, "aesDecrypt")
+	}
+
+	receipt := BlindReceipt{Confirmation: *conf}
+	if err := receipt.Memo.unpack(plain); err != nil {
+		return nil, errors.Annotate(err, "unpack StealthMemo")
+	}
+
+	if uint32(receipt.Memo.Check) != binary.LittleEndian.Uint32(secret) {
+		return nil, ErrInvalidStealthMemo
+	}
+
+	commitment, err := BlindCommit(receipt.Memo.BlindingFactor.Bytes(), uint64(receipt.Memo.Amount.Amount))
+	if err != nil {
+		return nil, errors.Annotate(err, "BlindCommit")
+	}
+
+	if !bytes.Equal(commitment, receipt.Memo.Commitment.Bytes()) {
+		return nil, ErrCommitmentMismatch
+	}
+
+	child := sha256.Sum256(secret)
+	childKey, err := ChildPrivateKey(priv, child[:])
+	if err != nil {
+		return nil, errors.Annotate(err, "ChildPrivateKey")
+	}
+
+	receipt.OwnerKey = *childKey.PublicKey()
+	if err := b.addKey(childKey); err != nil {
+		return nil, errors.Annotate(err, "addKey")
+	}
+
+	return &receipt, nil
+}
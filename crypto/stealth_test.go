@@ -0,0 +1,131 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/denkhaus/bitshares/config"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPedersenCommitment(t *testing.T) {
+	assert.True(t, btcec.S256().IsOnCurve(pedersenH.X, pedersenH.Y))
+
+	b1 := sha256.Sum256([]byte("blind 1"))
+	b2 := sha256.Sum256([]byte("blind 2"))
+
+	c1, err := BlindCommit(b1[:], 1000)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	c2, err := BlindCommit(b2[:], 234)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	//commitments are additive, so the sum commits to the summed value
+	p1, err := btcec.ParsePubKey(c1, btcec.S256())
+	assert.NoError(t, err)
+	p2, err := btcec.ParsePubKey(c2, btcec.S256())
+	assert.NoError(t, err)
+	x, y := btcec.S256().Add(p1.X, p1.Y, p2.X, p2.Y)
+
+	sum, err := BlindCommit(BlindSum([][]byte{b1[:], b2[:]}, nil), 1234)
+	if assert.NoError(t, err) {
+		assert.Equal(t, (&btcec.PublicKey{Curve: btcec.S256(), X: x, Y: y}).SerializeCompressed(), sum)
+	}
+
+	assert.Equal(t, b1[:], BlindSum([][]byte{b1[:], b2[:]}, [][]byte{b2[:]}))
+
+	_, err = BlindCommit(make([]byte, 32), 0)
+	assert.Equal(t, ErrInvalidBlindingFactor, err)
+}
+
+func TestStealthTransfer(t *testing.T) {
+	config.SetCurrent(config.ChainIDGPH)
+
+	priv, err := types.GeneratePrivateKey()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	offset := sha256.Sum256([]byte("offset"))
+	childPriv, err := ChildPrivateKey(priv, offset[:])
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	childPub, err := ChildPublicKey(priv.PublicKey(), offset[:])
+	if assert.NoError(t, err) {
+		assert.True(t, childPub.Equal(childPriv.PublicKey()))
+	}
+
+	bag := NewKeyBag()
+	assert.NoError(t, bag.Add(priv.ToWIF()))
+
+	amount := types.AssetAmount{Amount: 100000, Asset: *types.NewAssetID("1.3.121").(*types.AssetID)}
+	op, sent, err := NewTransferToBlindOperation(*types.NewAccountID("1.2.100").(*types.AccountID), *priv.PublicKey(), amount)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	//the blinding factor of the operation has to commit to the outputs
+	commitment, err := BlindCommit(op.BlindingFactor.Bytes(), uint64(amount.Amount))
+	if assert.NoError(t, err) && assert.Len(t, op.Outputs, 1) {
+		assert.Equal(t, commitment, op.Outputs[0].Commitment.Bytes())
+	}
+
+	conf, err := types.NewStealthConfirmationFromString(sent.Confirmation.String())
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	received, err := bag.ReceiveStealthConfirmation(conf)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, amount, received.Memo.Amount)
+	assert.Equal(t, sent.Memo.BlindingFactor, received.Memo.BlindingFactor)
+	assert.True(t, received.OwnerKey.Equal(&sent.OwnerKey))
+	assert.True(t, bag.Present(&received.OwnerKey))
+
+	stranger := NewKeyBag()
+	_, err = stranger.ReceiveStealthConfirmation(conf)
+	assert.Equal(t, ErrKeyNotFound, err)
+
+	_, second, err := NewStealthOutput(*priv.PublicKey(), priv.PublicKey(), amount)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	received2, err := bag.ReceiveStealthConfirmation(&second.Confirmation)
+	if assert.NoError(t, err) && assert.NotNil(t, received2.Memo.From) {
+		assert.True(t, received2.Memo.From.Equal(priv.PublicKey()))
+	}
+
+	fee := types.AssetAmount{Amount: 500, Asset: amount.Asset}
+	from, err := NewTransferFromBlindOperation(*types.NewAccountID("1.2.100").(*types.AccountID), fee, *received, *received2)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, types.Int64(2*100000-500), from.Amount.Amount)
+	assert.True(t, bytes.Compare(from.BlindInputs[0].Commitment.Bytes(), from.BlindInputs[1].Commitment.Bytes()) < 0)
+
+	//graphene checks commit(blinding_factor, amount + fee) == sum of inputs
+	total, err := BlindCommit(from.BlindFactor.Bytes(), uint64(from.Amount.Amount+fee.Amount))
+	if assert.NoError(t, err) {
+		in1, _ := btcec.ParsePubKey(received.Memo.Commitment.Bytes(), btcec.S256())
+		in2, _ := btcec.ParsePubKey(received2.Memo.Commitment.Bytes(), btcec.S256())
+		x, y := btcec.S256().Add(in1.X, in1.Y, in2.X, in2.Y)
+		assert.Equal(t, (&btcec.PublicKey{Curve: btcec.S256(), X: x, Y: y}).SerializeCompressed(), total)
+	}
+
+	_, err = NewTransferFromBlindOperation(from.To, types.AssetAmount{Amount: 500, Asset: *types.NewAssetID("1.3.0").(*types.AssetID)}, *received)
+	assert.Equal(t, ErrAssetMismatch, err)
+}
@@ -59,6 +59,48 @@ func (tx *TransactionSigner) Sign(privKeys types.PrivateKeys, chain *config.Chai
 	return nil
 }
 
+//SignWith signs the underlying transaction with the keys of pubs held by signer.
+//Signatures are created over the same digest, so a non canonical one restarts with an adjusted expiration.
+//Present signatures would be invalidated by that, a non canonical signature is an error then.
+func (tx *TransactionSigner) SignWith(signer Signer, pubs types.PublicKeys, chain *config.ChainConfig) error {
+	signatures := tx.Signatures
+
+	for attempt := 0; attempt < maxSignAttempts; attempt++ {
+		digest, err := tx.Digest(chain)
+		if err != nil {
+			return errors.Annotate(err, "Digest")
+		}
+
+		sigs := make(types.Signatures, 0, len(pubs))
+		for _, pub := range pubs {
+			sig, err := signer.SignCompact(&pub, digest)
+			if err != nil {
+				return errors.Annotatef(err, "SignCompact [%s]", pub)
+			}
+
+			if !isCanonical(sig) {
+				break
+			}
+
+			sigs = append(sigs, types.Buffer(sig))
+		}
+
+		if len(sigs) == len(pubs) {
+			tx.Signatures = append(signatures, sigs...)
+			return nil
+		}
+
+		if len(signatures) > 0 {
+			return errors.Annotate(ErrNonCanonicalSignature, "transaction is signed already")
+		}
+
+		//make canonical by adjusting expiration time
+		tx.AdjustExpiration(time.Second)
+	}
+
+	return errors.Annotatef(ErrNonCanonicalSignature, "no canonical signatures after %d attempts", maxSignAttempts)
+}
+
 //Verify verifies the underlying transaction against a given KeyBag
 func (tx *TransactionSigner) Verify(keyBag *KeyBag, chain *config.ChainConfig) (bool, error) {
 	dig, err := tx.Digest(chain)
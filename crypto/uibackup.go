@@ -0,0 +1,314 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
+	"github.com/pquerna/ffjson/ffjson"
+	"github.com/ulikunitz/xz/lzma"
+)
+
+//uiBackupNonce is the nonce the reference UI encrypts backups with, a stringified javascript null.
+const uiBackupNonce = "null"
+
+var (
+	ErrInvalidBackup = errors.New("invalid wallet backup")
+)
+
+//UIBackupWallet is the wallet record of a reference UI backup.
+type UIBackupWallet struct {
+	PublicName         string `json:"public_name"`
+	PasswordPubKey     string `json:"password_pubkey"`
+	EncryptionKey      string `json:"encryption_key"`
+	EncryptedBrainKey  string `json:"encrypted_brainkey"`
+	BrainKeyPubKey     string `json:"brainkey_pubkey"`
+	BrainKeySequence   int    `json:"brainkey_sequence"`
+	BrainKeyBackupDate string `json:"brainkey_backup_date,omitempty"`
+	Created            string `json:"created,omitempty"`
+	LastModified       string `json:"last_modified,omitempty"`
+	ChainID            string `json:"chain_id"`
+}
+
+//UIBackupPrivateKey is an encrypted private key of a reference UI backup.
+type UIBackupPrivateKey struct {
+	EncryptedKey     string `json:"encrypted_key"`
+	PubKey           string `json:"pubkey"`
+	BrainKeySequence *int   `json:"brainkey_sequence,omitempty"`
+}
+
+//UIBackupLinkedAccount is an account linked to a reference UI wallet.
+type UIBackupLinkedAccount struct {
+	Name    string `json:"name"`
+	ChainID string `json:"chainId"`
+}
+
+//UIBackup is the content of a reference UI wallet backup (.bin) file.
+type UIBackup struct {
+	Wallet         []UIBackupWallet        `json:"wallet"`
+	PrivateKeys    []UIBackupPrivateKey    `json:"private_keys"`
+	LinkedAccounts []UIBackupLinkedAccount `json:"linked_accounts"`
+}
+
+//OpenUIBackup reads and decrypts the backup file at path.
+func OpenUIBackup(path, password string) (*UIBackup, error) {
+	inFile, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Errorf("open wallet backup [%s], %s", path, err)
+	}
+	defer inFile.Close()
+
+	return ReadUIBackup(inFile, password)
+}
+
+//ReadUIBackup reads and decrypts a backup from r.
+func ReadUIBackup(r io.Reader, password string) (*UIBackup, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Annotate(err, "ReadAll")
+	}
+
+	return DecryptUIBackup(data, password)
+}
+
+//DecryptUIBackup decrypts a backup, which is the compressed wallet JSON
+//ECIES encrypted to the public key of sha256(password), prefixed by the one-time public key.
+func DecryptUIBackup(data []byte, password string) (*UIBackup, error) {
+	if len(data) <= btcec.PubKeyBytesLenCompressed {
+		return nil, ErrInvalidBackup
+	}
+
+	pub, err := btcec.ParsePubKey(data[:btcec.PubKeyBytesLenCompressed], btcec.S256())
+	if err != nil {
+		return nil, ErrInvalidBackup
+	}
+
+	oneTimePub, err := types.NewPublicKey(pub)
+	if err != nil {
+		return nil, errors.Annotate(err, "NewPublicKey")
+	}
+
+	priv, err := uiPasswordKey(password)
+	if err != nil {
+		return nil, errors.Annotate(err, "uiPasswordKey")
+	}
+
+	compressed, err := decryptWithChecksum(priv, oneTimePub, data[btcec.PubKeyBytesLenCompressed:])
+	if err != nil {
+		return nil, err
+	}
+
+	rd, err := lzma.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, errors.Annotate(err, "NewReader [lzma]")
+	}
+
+	plain, err := ioutil.ReadAll(rd)
+	if err != nil {
+		return nil, errors.Annotate(err, "ReadAll [lzma]")
+	}
+
+	backup := UIBackup{}
+	if err := ffjson.Unmarshal(plain, &backup); err != nil {
+		return nil, errors.Annotate(err, "Unmarshal [UIBackup]")
+	}
+
+	return &backup, nil
+}
+
+//Encrypt creates a backup of p the reference UI can restore with password.
+func (p UIBackup) Encrypt(password string) ([]byte, error) {
+	plain, err := ffjson.Marshal(p)
+	if err != nil {
+		return nil, errors.Annotate(err, "Marshal [UIBackup]")
+	}
+
+	var compressed bytes.Buffer
+	wr, err := lzma.WriterConfig{
+		SizeInHeader: true,
+		Size:         int64(len(plain)),
+	}.NewWriter(&compressed)
+	if err != nil {
+		return nil, errors.Annotate(err, "NewWriter [lzma]")
+	}
+
+	if _, err := wr.Write(plain); err != nil {
+		return nil, errors.Annotate(err, "Write [lzma]")
+	}
+
+	if err := wr.Close(); err != nil {
+		return nil, errors.Annotate(err, "Close [lzma]")
+	}
+
+	priv, err := uiPasswordKey(password)
+	if err != nil {
+		return nil, errors.Annotate(err, "uiPasswordKey")
+	}
+
+	oneTime, err := types.GeneratePrivateKey()
+	if err != nil {
+		return nil, errors.Annotate(err, "GeneratePrivateKey")
+	}
+	defer oneTime.Zero()
+
+	data, err := encryptWithChecksum(oneTime, priv.PublicKey(), compressed.Bytes())
+	if err != nil {
+		return nil, errors.Annotate(err, "encryptWithChecksum")
+	}
+
+	return append(oneTime.PublicKey().Bytes(), data...), nil
+}
+
+//Unlock decrypts the private keys and the brain key of the backup's wallet with password.
+//Keys derived from the brain key up to the wallet's brain key sequence are added to the KeyBag too.
+func (p UIBackup) Unlock(password string) (*KeyBag, string, error) {
+	if len(p.Wallet) == 0 {
+		return nil, "", ErrInvalidBackup
+	}
+
+	wallet := p.Wallet[0]
+	passwordKey, err := uiPasswordKey(password)
+	if err != nil {
+		return nil, "", errors.Annotate(err, "uiPasswordKey")
+	}
+
+	pub, err := types.NewPublicKeyFromString(wallet.PasswordPubKey)
+	if err != nil {
+		return nil, "", errors.Annotate(err, "NewPublicKeyFromString [password_pubkey]")
+	}
+
+	if !pub.Equal(passwordKey.PublicKey()) {
+		return nil, "", ErrInvalidPassword
+	}
+
+	pwSeed := sha512.Sum512([]byte(password))
+	encryptionKey, err := aesDecryptHex(pwSeed[:], wallet.EncryptionKey)
+	if err != nil {
+		return nil, "", errors.Annotate(err, "decrypt [encryption_key]")
+	}
+	defer zeroBytes(encryptionKey)
+
+	seed := sha512.Sum512(encryptionKey)
+	defer zeroBytes(seed[:])
+
+	bag := NewKeyBag()
+	unlocked := false
+	defer func() {
+		if !unlocked {
+			bag.zero()
+		}
+	}()
+
+	for _, key := range p.PrivateKeys {
+		secret, err := aesDecryptHex(seed[:], key.EncryptedKey)
+		if err != nil {
+			return nil, "", errors.Annotatef(err, "decrypt [%s]", key.PubKey)
+		}
+
+		// keys are stored as big endian numbers, leading zeros may be missing
+		if len(secret) < btcec.PrivKeyBytesLen {
+			secret = append(make([]byte, btcec.PrivKeyBytesLen-len(secret)), secret...)
+		}
+
+		priv, err := types.NewPrivateKeyFromSecret(secret)
+		zeroBytes(secret)
+		if err != nil {
+			return nil, "", errors.Annotatef(err, "NewPrivateKeyFromSecret [%s]", key.PubKey)
+		}
+
+		if err := bag.addKey(priv); err != nil {
+			return nil, "", errors.Annotatef(err, "addKey [%s]", key.PubKey)
+		}
+	}
+
+	var brainKey string
+	if wallet.EncryptedBrainKey != "" {
+		plain, err := aesDecryptHex(seed[:], wallet.EncryptedBrainKey)
+		if err != nil {
+			return nil, "", errors.Annotate(err, "decrypt [encrypted_brainkey]")
+		}
+
+		brainKey = NormalizeBrainKey(string(plain))
+		zeroBytes(plain)
+
+		for i := 0; i <= wallet.BrainKeySequence; i++ {
+			priv, err := DerivePrivateKey(brainKey, i)
+			if err != nil {
+				return nil, "", errors.Annotatef(err, "DerivePrivateKey [%d]", i)
+			}
+
+			if err := bag.addKey(priv); err != nil {
+				return nil, "", errors.Annotatef(err, "addKey [%d]", i)
+			}
+		}
+	}
+
+	unlocked = true
+	return bag, brainKey, nil
+}
+
+//uiPasswordKey is the reference UI's PrivateKey.fromSeed(password).
+func uiPasswordKey(password string) (*types.PrivateKey, error) {
+	secret := sha256.Sum256([]byte(password))
+	return types.NewPrivateKeyFromSecret(secret[:])
+}
+
+//uiSharedSeed is the AES seed bitshares-js Aes.encrypt_with_checksum derives from
+//a shared secret S, sha512("" + nonce + hex(S)). The reference UI passes a null nonce
+//for backups, which javascript stringifies to "null".
+func uiSharedSeed(priv *types.PrivateKey, pub *types.PublicKey) ([]byte, error) {
+	sec, err := priv.SharedSecret(pub, 16, 16)
+	if err != nil {
+		return nil, errors.Annotate(err, "SharedSecret")
+	}
+
+	ss := sha512.Sum512(sec)
+	seed := sha512.Sum512([]byte(uiBackupNonce + hex.EncodeToString(ss[:])))
+	return seed[:], nil
+}
+
+func encryptWithChecksum(priv *types.PrivateKey, pub *types.PublicKey, plain []byte) ([]byte, error) {
+	seed, err := uiSharedSeed(priv, pub)
+	if err != nil {
+		return nil, errors.Annotate(err, "uiSharedSeed")
+	}
+
+	digest := sha256.Sum256(plain)
+	return aesEncrypt(seed, append(digest[:4], plain...))
+}
+
+func decryptWithChecksum(priv *types.PrivateKey, pub *types.PublicKey, data []byte) ([]byte, error) {
+	seed, err := uiSharedSeed(priv, pub)
+	if err != nil {
+		return nil, errors.Annotate(err, "uiSharedSeed")
+	}
+
+	// a wrong password shows up as broken padding or checksum
+	plain, err := aesDecrypt(seed, data)
+	if err != nil || len(plain) < 4 {
+		return nil, ErrInvalidPassword
+	}
+
+	digest := sha256.Sum256(plain[4:])
+	if !bytes.Equal(digest[:4], plain[:4]) {
+		return nil, ErrInvalidPassword
+	}
+
+	return plain[4:], nil
+}
+
+func aesDecryptHex(seed []byte, data string) ([]byte, error) {
+	buf, err := hex.DecodeString(data)
+	if err != nil {
+		return nil, errors.Annotate(err, "DecodeString")
+	}
+
+	return aesDecrypt(seed, buf)
+}
@@ -0,0 +1,70 @@
+package crypto
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/denkhaus/bitshares/config"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/stretchr/testify/assert"
+)
+
+//testdata/uibackup.bin was written following bitsharesjs Aes.encrypt_with_checksum
+//with the null nonce of bitshares-ui BackupActions and the wallet encryption of WalletDb,
+//password "fixture password". It holds the private key of
+//BTS6UUbAGbTLLWfY2gAc8XmjGBz2c7WT4fYB5r1L1aHDwAY88ujex and the brain key "alpha beta gamma".
+const (
+	uiBackupPassword = "fixture password"
+	uiBackupWIF      = "5HueCGU8rMjxEXxiPuD5BDku4MkFqeZyd4dZ1jvhTVqvbTLvyTJ"
+)
+
+func TestUIBackup(t *testing.T) {
+	config.SetCurrent(config.ChainIDBTS)
+
+	path := filepath.Join("testdata", "uibackup.bin")
+	_, err := OpenUIBackup(path, "wrong")
+	assert.Equal(t, ErrInvalidPassword, err)
+
+	backup, err := OpenUIBackup(path, uiBackupPassword)
+	if !assert.NoError(t, err) || !assert.Len(t, backup.Wallet, 1) {
+		return
+	}
+
+	assert.Equal(t, "default", backup.Wallet[0].PublicName)
+	assert.Equal(t, []UIBackupLinkedAccount{{
+		Name:    "alice",
+		ChainID: "4018d7844c78f6a6c41c6a552b898022310fc5dec06da467ee7905a8dad512c8",
+	}}, backup.LinkedAccounts)
+
+	_, _, err = backup.Unlock("wrong")
+	assert.Equal(t, ErrInvalidPassword, err)
+
+	bag, brainKey, err := backup.Unlock(uiBackupPassword)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "ALPHA BETA GAMMA", brainKey)
+	assert.Len(t, bag.Publics(), 2)
+
+	imported, err := types.NewPublicKeyFromString("BTS6UUbAGbTLLWfY2gAc8XmjGBz2c7WT4fYB5r1L1aHDwAY88ujex")
+	if assert.NoError(t, err) && assert.NotNil(t, bag.Private(imported)) {
+		assert.Equal(t, uiBackupWIF, bag.Private(imported).ToWIF())
+	}
+
+	derived, err := DerivePrivateKey("ALPHA BETA GAMMA", 0)
+	if assert.NoError(t, err) {
+		assert.True(t, bag.Present(derived.PublicKey()))
+	}
+
+	// backups written by Encrypt use the same scheme
+	data, err := backup.Encrypt(uiBackupPassword)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	restored, err := DecryptUIBackup(data, uiBackupPassword)
+	if assert.NoError(t, err) {
+		assert.Equal(t, backup, restored)
+	}
+}
@@ -0,0 +1,150 @@
+package crypto
+
+import (
+	"github.com/denkhaus/bitshares/operations"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
+)
+
+var (
+	ErrNoVoteChanges     = errors.New("no vote changes")
+	ErrTooFewVotes       = errors.New("fewer votes than the desired number of witnesses or committee members")
+	ErrVoteCountExceeded = errors.New("desired number of witnesses or committee members exceeds the chain maximum")
+	ErrUnknownVoteID     = errors.New("vote id does not exist")
+)
+
+//VoteUpdate collects changes to the votes, voting proxy and desired numbers
+//of witnesses and committee members of an account.
+type VoteUpdate struct {
+	account *types.Account
+	options types.AccountOptions
+	changed bool
+}
+
+//NewVoteUpdate creates a VoteUpdate based on the current options of account.
+func NewVoteUpdate(account *types.Account) *VoteUpdate {
+	update := VoteUpdate{
+		account: account,
+		options: account.Options,
+	}
+
+	update.options.Votes = append(types.Votes{}, account.Options.Votes...)
+	return &update
+}
+
+//AddVotes votes for voteIDs. Votes already present are ignored.
+func (p *VoteUpdate) AddVotes(voteIDs ...types.VoteID) *VoteUpdate {
+	for _, id := range voteIDs {
+		if !p.voted(id) {
+			p.options.Votes = append(p.options.Votes, id)
+		}
+	}
+
+	p.changed = true
+	return p
+}
+
+//RemoveVotes withdraws the votes for voteIDs.
+func (p *VoteUpdate) RemoveVotes(voteIDs ...types.VoteID) *VoteUpdate {
+	votes := types.Votes{}
+	for _, vote := range p.options.Votes {
+		keep := true
+		for _, id := range voteIDs {
+			if vote == id {
+				keep = false
+				break
+			}
+		}
+
+		if keep {
+			votes = append(votes, vote)
+		}
+	}
+
+	p.options.Votes = votes
+	p.changed = true
+	return p
+}
+
+func (p *VoteUpdate) voted(id types.VoteID) bool {
+	for _, vote := range p.options.Votes {
+		if vote == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+//SetProxy lets proxy vote on behalf of the account, whose own votes are ignored meanwhile.
+func (p *VoteUpdate) SetProxy(proxy types.GrapheneObject) *VoteUpdate {
+	p.options.VotingAccount = types.AccountIDFromObject(proxy)
+	p.changed = true
+	return p
+}
+
+//ClearProxy makes the account vote for itself.
+func (p *VoteUpdate) ClearProxy() *VoteUpdate {
+	return p.SetProxy(types.NewAccountID(types.ProxyToSelfAccount))
+}
+
+//SetNumWitness sets the desired number of active witnesses.
+func (p *VoteUpdate) SetNumWitness(num uint16) *VoteUpdate {
+	p.options.NumWitness = types.UInt16(num)
+	p.changed = true
+	return p
+}
+
+//SetNumCommittee sets the desired number of active committee members.
+func (p *VoteUpdate) SetNumCommittee(num uint16) *VoteUpdate {
+	p.options.NumCommittee = types.UInt16(num)
+	p.changed = true
+	return p
+}
+
+//Operation validates the changes like account_options::validate
+//and returns the AccountUpdateOperation applying them.
+func (p *VoteUpdate) Operation() (*operations.AccountUpdateOperation, error) {
+	if !p.changed {
+		return nil, ErrNoVoteChanges
+	}
+
+	witnesses, committee := 0, 0
+	for _, vote := range p.options.Votes {
+		switch vote.Type() {
+		case types.VoteTypeWitness:
+			witnesses++
+		case types.VoteTypeCommittee:
+			committee++
+		}
+	}
+
+	if int(p.options.NumWitness) > witnesses || int(p.options.NumCommittee) > committee {
+		return nil, ErrTooFewVotes
+	}
+
+	options := p.options
+	op := operations.AccountUpdateOperation{
+		Account:    p.account.ID,
+		Extensions: types.AccountUpdateExtensions{},
+		NewOptions: &options,
+	}
+
+	return &op, nil
+}
+
+//Check validates the changes against the chain state like the account_update evaluator.
+func (p *VoteUpdate) Check(props *types.GlobalProperties) error {
+	params := props.Parameters
+	if p.options.NumWitness > params.MaximumWitnessCount || p.options.NumCommittee > params.MaximumCommitteeCount {
+		return ErrVoteCountExceeded
+	}
+
+	for _, vote := range p.options.Votes {
+		if vote.Instance() >= int(props.NextAvailableVoteID) {
+			return errors.Annotate(ErrUnknownVoteID, vote.String())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVoteUpdate(t *testing.T) {
+	account := types.Account{ID: accountID("1.2.100")}
+	account.Options.VotingAccount = accountID("1.2.200")
+	account.Options.Votes = types.Votes{*types.NewVoteID("1:10")}
+
+	_, err := NewVoteUpdate(&account).Operation()
+	assert.Equal(t, ErrNoVoteChanges, err)
+
+	_, err = NewVoteUpdate(&account).SetNumWitness(2).Operation()
+	assert.Equal(t, ErrTooFewVotes, err)
+
+	update := NewVoteUpdate(&account).
+		AddVotes(*types.NewVoteID("1:11"), *types.NewVoteID("1:10"), *types.NewVoteID("0:3")).
+		RemoveVotes(*types.NewVoteID("0:3")).
+		SetNumWitness(2).
+		ClearProxy()
+
+	op, err := update.Operation()
+	if assert.NoError(t, err) && assert.NotNil(t, op.NewOptions) {
+		assert.Len(t, op.NewOptions.Votes, 2)
+		assert.Equal(t, types.ProxyToSelfAccount, op.NewOptions.VotingAccount.String())
+		assert.Equal(t, types.UInt16(2), op.NewOptions.NumWitness)
+		assert.Nil(t, op.Owner)
+		assert.Nil(t, op.Active)
+	}
+
+	//the account itself is left untouched
+	assert.Len(t, account.Options.Votes, 1)
+
+	props := types.GlobalProperties{NextAvailableVoteID: 12}
+	props.Parameters.MaximumWitnessCount = 1
+	assert.Equal(t, ErrVoteCountExceeded, update.Check(&props))
+
+	props.Parameters.MaximumWitnessCount = 101
+	assert.NoError(t, update.Check(&props))
+
+	props.NextAvailableVoteID = 11
+	assert.Equal(t, ErrUnknownVoteID, errors.Cause(update.Check(&props)))
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/denkhaus/bitshares"
+	"github.com/denkhaus/bitshares/config"
+	"github.com/denkhaus/bitshares/crypto"
+	"github.com/denkhaus/bitshares/operations"
+	"github.com/denkhaus/bitshares/tests"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
+	"github.com/pquerna/ffjson/ffjson"
+)
+
+//usage:
+//  online:  offline prepare -file tx.json
+//  offline: offline sign -file tx.json -wif <key>
+//  online:  offline broadcast -file tx.json
+
+const (
+	wsTestApiUrl = "wss://node.testnet.bitshares.eu/ws"
+)
+
+var (
+	file = flag.String("file", "tx.json", "transaction file")
+	wif  = flag.String("wif", "", "private key used by sign")
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		log.Fatal("command prepare, sign or broadcast required")
+	}
+
+	cmd := os.Args[1]
+	flag.CommandLine.Parse(os.Args[2:])
+	config.SetCurrent(config.ChainIDTest)
+
+	var err error
+	switch cmd {
+	case "prepare":
+		err = prepare()
+	case "sign":
+		err = sign()
+	case "broadcast":
+		err = broadcast()
+	default:
+		err = errors.Errorf("unknown command %q", cmd)
+	}
+
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func connect() (bitshares.WebsocketAPI, error) {
+	api := bitshares.NewWebsocketAPI(wsTestApiUrl)
+	if err := api.Connect(); err != nil {
+		return nil, errors.Annotate(err, "Connect")
+	}
+
+	return api, nil
+}
+
+func prepare() error {
+	api, err := connect()
+	if err != nil {
+		return err
+	}
+	defer api.Close()
+
+	op := operations.TransferOperation{
+		From:       types.AccountIDFromObject(tests.TestAccount1ID),
+		To:         types.AccountIDFromObject(tests.TestAccount2ID),
+		Amount:     types.AssetAmount{Asset: types.AssetIDFromObject(tests.AssetTEST), Amount: 10000},
+		Extensions: types.Extensions{},
+	}
+
+	otx, err := api.PrepareOfflineTransaction(time.Hour, tests.AssetTEST, &op)
+	if err != nil {
+		return errors.Annotate(err, "PrepareOfflineTransaction")
+	}
+
+	return otx.Save(*file)
+}
+
+func sign() error {
+	otx, err := crypto.OpenOfflineTransaction(*file)
+	if err != nil {
+		return errors.Annotate(err, "OpenOfflineTransaction")
+	}
+
+	// show what is going to be signed
+	data, err := ffjson.Marshal(otx.Transaction.Operations)
+	if err != nil {
+		return errors.Annotate(err, "Marshal")
+	}
+	fmt.Printf("signing %s\nexpiration %s\n", data, otx.Transaction.Expiration.Time)
+
+	bag := crypto.NewKeyBag()
+	if err := bag.Add(*wif); err != nil {
+		return errors.Annotate(err, "Add [wif]")
+	}
+
+	if _, err := otx.Sign(bag, time.Now()); err != nil {
+		return errors.Annotate(err, "Sign")
+	}
+
+	return otx.Save(*file)
+}
+
+func broadcast() error {
+	otx, err := crypto.OpenOfflineTransaction(*file)
+	if err != nil {
+		return errors.Annotate(err, "OpenOfflineTransaction")
+	}
+
+	api, err := connect()
+	if err != nil {
+		return err
+	}
+	defer api.Close()
+
+	if err := api.BroadcastOfflineTransaction(otx); err != nil {
+		return errors.Annotate(err, "BroadcastOfflineTransaction")
+	}
+
+	fmt.Println("broadcast successful")
+	return nil
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/denkhaus/bitshares/config"
+	"github.com/denkhaus/bitshares/crypto"
+	"github.com/denkhaus/bitshares/signer"
+	"github.com/juju/errors"
+)
+
+var (
+	keyStorePath = flag.String("keystore", "keys.json", "encrypted key store file")
+	policyPath   = flag.String("policy", "policy.json", "signing policy file")
+	auditPath    = flag.String("audit", "audit.log", "audit log file")
+	statePath    = flag.String("state", "spent.json", "daily spend state file")
+	network      = flag.String("network", "unix", "listener network, unix or tcp, which needs SIGNER_TOKEN")
+	address      = flag.String("address", "signer.sock", "listener address")
+	chainID      = flag.String("chain", config.ChainIDBTS, "chain id")
+)
+
+func main() {
+	flag.Parse()
+
+	if err := config.SetCurrent(*chainID); err != nil {
+		log.Fatal(errors.Annotate(err, "SetCurrent"))
+	}
+
+	ks, err := crypto.OpenKeyStore(*keyStorePath)
+	if err != nil {
+		log.Fatal(errors.Annotate(err, "OpenKeyStore"))
+	}
+
+	if err := ks.Unlock(os.Getenv("SIGNER_PASSWORD")); err != nil {
+		log.Fatal(errors.Annotate(err, "Unlock"))
+	}
+	defer ks.Lock()
+
+	keys, err := ks.Signer()
+	if err != nil {
+		log.Fatal(errors.Annotate(err, "Signer"))
+	}
+
+	policy, err := signer.LoadPolicy(*policyPath)
+	if err != nil {
+		log.Fatal(errors.Annotate(err, "LoadPolicy"))
+	}
+
+	if err := policy.Persist(*statePath); err != nil {
+		log.Fatal(errors.Annotate(err, "Persist"))
+	}
+
+	audit, err := os.OpenFile(*auditPath, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		log.Fatal(errors.Annotate(err, "OpenFile [audit]"))
+	}
+	defer audit.Close()
+
+	server := signer.NewServer(signer.NewService(keys, policy, audit))
+	server.SetToken(os.Getenv("SIGNER_TOKEN"))
+	if err := server.Listen(*network, *address); err != nil {
+		log.Fatal(errors.Annotate(err, "Listen"))
+	}
+
+	go func() {
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+		<-sig
+		server.Close()
+	}()
+
+	log.Printf("signing with %d keys on %s", len(keys.Publics()), server.Addr())
+	if err := server.Serve(); err != nil {
+		log.Fatal(errors.Annotate(err, "Serve"))
+	}
+}
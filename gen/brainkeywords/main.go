@@ -0,0 +1,92 @@
+//brainkeywords writes graphene's brain key dictionary as Go source.
+//The words are read from graphene's words.cpp, either a local copy or the upstream file.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+const (
+	wordsURL   = "https://raw.githubusercontent.com/bitshares/bitshares-core/master/libraries/utilities/words.cpp"
+	wordsCount = 49744
+)
+
+var (
+	src = flag.String("src", wordsURL, "path or url of graphene's words.cpp")
+	out = flag.String("out", "gen_brainkeywords.go", "output file")
+	pkg = flag.String("pkg", "crypto", "package name")
+
+	wordExpr = regexp.MustCompile(`"([a-z]+)"`)
+)
+
+func read(src string) ([]byte, error) {
+	if !strings.HasPrefix(src, "http://") && !strings.HasPrefix(src, "https://") {
+		return ioutil.ReadFile(src)
+	}
+
+	resp, err := http.Get(src)
+	if err != nil {
+		return nil, errors.Annotate(err, "Get")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("get %s: %s", src, resp.Status)
+	}
+
+	return ioutil.ReadAll(resp.Body)
+}
+
+func main() {
+	flag.Parse()
+
+	data, err := read(*src)
+	if err != nil {
+		log.Fatal(errors.Annotate(err, "read"))
+	}
+
+	//the word list is the only string array of words.cpp
+	start := bytes.IndexByte(data, '{')
+	end := bytes.LastIndexByte(data, '}')
+	if start < 0 || end < start {
+		log.Fatalf("no word list found in %s", *src)
+	}
+
+	words := []string{}
+	for _, match := range wordExpr.FindAllSubmatch(data[start:end], -1) {
+		words = append(words, string(match[1]))
+	}
+
+	if len(words) != wordsCount {
+		log.Fatalf("found %d words in %s, expected %d", len(words), *src, wordsCount)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gen/brainkeywords from %s. DO NOT EDIT.\n\n", *src)
+	fmt.Fprintf(&buf, "package %s\n\n", *pkg)
+	fmt.Fprintf(&buf, "//brainKeyWords is graphene's standard brain key dictionary.\n")
+	fmt.Fprintf(&buf, "var brainKeyWords = []string{\n")
+	for _, word := range words {
+		fmt.Fprintf(&buf, "\t%q,\n", word)
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	code, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(errors.Annotate(err, "Source"))
+	}
+
+	if err := ioutil.WriteFile(*out, code, 0644); err != nil {
+		log.Fatal(errors.Annotate(err, "WriteFile"))
+	}
+}
@@ -24,6 +24,7 @@ require (
 	github.com/stretchr/objx v0.2.0
 	github.com/stretchr/testify v1.3.0
 	github.com/tevino/abool v0.0.0-20170917061928-9b9efcf221b5
+	github.com/ulikunitz/xz v0.5.12
 	golang.org/x/crypto v0.0.0-20190418165655-df01cb2cc480
 	golang.org/x/net v0.0.0-20190420063019-afa5a82059c6
 	golang.org/x/sys v0.0.0-20190419153524-e8e3143a4f4a // indirect
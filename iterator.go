@@ -0,0 +1,497 @@
+package bitshares
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
+	"github.com/pquerna/ffjson/ffjson"
+)
+
+//HistoryDirection defines the order in which an AccountHistoryIterator walks the history.
+type HistoryDirection int
+
+const (
+	//HistoryBackward walks from the most recent to the oldest operation.
+	HistoryBackward HistoryDirection = iota
+	//HistoryForward walks from the oldest to the most recent operation.
+	HistoryForward
+)
+
+//IteratorOptions configures the paginated iterators.
+type IteratorOptions struct {
+	//PageSize is the number of items requested per API call.
+	//Zero or values above the node limit select the node limit.
+	PageSize int
+	//Prefetch is the number of pages fetched ahead in the background
+	//while the caller consumes the current page. Zero fetches synchronously.
+	Prefetch int
+	//Cursor resumes the iteration after the item a previous iterator's Cursor returned.
+	Cursor string
+}
+
+type pageItem struct {
+	value  interface{}
+	cursor string
+}
+
+type page struct {
+	items []pageItem
+	next  string
+	last  bool
+	err   error
+}
+
+type pageFetchFunc func(cursor string, size int) page
+
+//pageIterator is the common engine of all paginated iterators.
+type pageIterator struct {
+	fetch     pageFetchFunc
+	size      int
+	next      string
+	last      bool
+	pages     chan page
+	done      chan struct{}
+	closeOnce sync.Once
+	current   []pageItem
+	value     interface{}
+	cursor    string
+	err       error
+}
+
+func newPageIterator(fetch pageFetchFunc, limit int, opts *IteratorOptions) *pageIterator {
+	if opts == nil {
+		opts = &IteratorOptions{}
+	}
+
+	size := opts.PageSize
+	if size <= 0 || size > limit {
+		size = limit
+	}
+
+	it := &pageIterator{
+		fetch:  fetch,
+		size:   size,
+		next:   opts.Cursor,
+		cursor: opts.Cursor,
+		done:   make(chan struct{}),
+	}
+
+	if opts.Prefetch > 0 {
+		it.pages = make(chan page, opts.Prefetch)
+		go it.prefetch()
+	}
+
+	return it
+}
+
+func (p *pageIterator) prefetch() {
+	defer close(p.pages)
+
+	next := p.next
+	for {
+		pg := p.fetch(next, p.size)
+
+		select {
+		case p.pages <- pg:
+		case <-p.done:
+			return
+		}
+
+		if pg.last || pg.err != nil {
+			return
+		}
+
+		next = pg.next
+	}
+}
+
+func (p *pageIterator) nextPage() (page, bool) {
+	if p.pages != nil {
+		pg, ok := <-p.pages
+		return pg, ok
+	}
+
+	if p.last {
+		return page{}, false
+	}
+
+	pg := p.fetch(p.next, p.size)
+	p.next = pg.next
+	p.last = pg.last || pg.err != nil
+	return pg, true
+}
+
+//Next advances the iterator to the next item.
+//It returns false if the iteration is exhausted or an error occurred, see Err.
+func (p *pageIterator) Next() bool {
+	if p.err != nil {
+		return false
+	}
+
+	for len(p.current) == 0 {
+		pg, ok := p.nextPage()
+		if !ok {
+			p.value = nil
+			return false
+		}
+
+		if pg.err != nil {
+			p.err = pg.err
+			p.value = nil
+			return false
+		}
+
+		p.current = pg.items
+	}
+
+	item := p.current[0]
+	p.current = p.current[1:]
+	p.value = item.value
+	p.cursor = item.cursor
+
+	return true
+}
+
+//Err returns the error that stopped the iteration, if any.
+func (p *pageIterator) Err() error {
+	return p.err
+}
+
+//Cursor returns the position of the current item.
+//Pass it as IteratorOptions.Cursor to resume the iteration after this item.
+func (p *pageIterator) Cursor() string {
+	return p.cursor
+}
+
+//Close stops background fetching. It is safe to call Close multiple times.
+func (p *pageIterator) Close() error {
+	p.closeOnce.Do(func() {
+		close(p.done)
+	})
+
+	return nil
+}
+
+//AccountHistoryIterator walks the complete operation history of an account.
+type AccountHistoryIterator struct {
+	*pageIterator
+}
+
+//Value returns the current OperationHistory.
+func (p *AccountHistoryIterator) Value() *types.OperationHistory {
+	if p.value == nil {
+		return nil
+	}
+
+	hist := p.value.(types.OperationHistory)
+	return &hist
+}
+
+//NewAccountHistoryIterator creates an iterator over the operation history of account.
+//HistoryBackward cursors are OperationHistoryIDs, HistoryForward cursors are account related sequence numbers.
+func NewAccountHistoryIterator(api WebsocketAPI, account types.GrapheneObject, direction HistoryDirection, opts *IteratorOptions) *AccountHistoryIterator {
+	fetch := fetchAccountHistoryBackward(api, account)
+	if direction == HistoryForward {
+		fetch = fetchAccountHistoryForward(api, account)
+	}
+
+	return &AccountHistoryIterator{
+		pageIterator: newPageIterator(fetch, GetAccountHistoryLimit, opts),
+	}
+}
+
+func fetchAccountHistoryBackward(api WebsocketAPI, account types.GrapheneObject) pageFetchFunc {
+	stop := types.NewOperationHistoryID("1.11.0")
+
+	return func(cursor string, size int) page {
+		start := types.NewOperationHistoryID("1.11.0")
+		if cursor != "" {
+			var id types.OperationHistoryID
+			if err := id.Parse(cursor); err != nil {
+				return page{err: errors.Annotate(err, "Parse [cursor]")}
+			}
+
+			// the oldest operation has been delivered already
+			if id.Instance() <= 1 {
+				return page{last: true}
+			}
+
+			start = types.NewOperationHistoryID(
+				fmt.Sprintf("1.11.%d", id.Instance()-1),
+			)
+		}
+
+		hists, err := api.GetAccountHistory(account, stop, size, start)
+		if err != nil {
+			return page{err: errors.Annotate(err, "GetAccountHistory")}
+		}
+
+		pg := page{
+			items: make([]pageItem, 0, len(hists)),
+			last:  len(hists) < size,
+		}
+
+		for _, hist := range hists {
+			pg.items = append(pg.items, pageItem{
+				value:  hist,
+				cursor: hist.ID.ID(),
+			})
+		}
+
+		if len(hists) > 0 {
+			pg.next = hists[len(hists)-1].ID.ID()
+		}
+
+		return pg
+	}
+}
+
+func fetchAccountHistoryForward(api WebsocketAPI, account types.GrapheneObject) pageFetchFunc {
+	return func(cursor string, size int) page {
+		var seq uint64
+		if cursor != "" {
+			s, err := strconv.ParseUint(cursor, 10, 64)
+			if err != nil {
+				return page{err: errors.Annotate(err, "ParseUint [cursor]")}
+			}
+			seq = s
+		}
+
+		// sequence numbers are inclusive and start at 1
+		stop := seq + 1
+		start := seq + uint64(size)
+
+		hists, err := api.GetRelativeAccountHistory(account, int64(stop), size, int64(start))
+		if err != nil {
+			return page{err: errors.Annotate(err, "GetRelativeAccountHistory")}
+		}
+
+		pg := page{
+			items: make([]pageItem, 0, len(hists)),
+			last:  len(hists) < size,
+			next:  cursor,
+		}
+
+		// relative history is delivered most recent first
+		for idx := len(hists) - 1; idx >= 0; idx-- {
+			seq++
+			pg.items = append(pg.items, pageItem{
+				value:  hists[idx],
+				cursor: strconv.FormatUint(seq, 10),
+			})
+		}
+
+		if len(hists) > 0 {
+			pg.next = strconv.FormatUint(seq, 10)
+		}
+
+		return pg
+	}
+}
+
+//AssetIterator walks all assets in alphabetical order of their symbols.
+type AssetIterator struct {
+	*pageIterator
+}
+
+//Value returns the current Asset.
+func (p *AssetIterator) Value() *types.Asset {
+	if p.value == nil {
+		return nil
+	}
+
+	asset := p.value.(types.Asset)
+	return &asset
+}
+
+//NewAssetIterator creates an iterator over all assets. Cursors are asset symbols.
+func NewAssetIterator(api WebsocketAPI, opts *IteratorOptions) *AssetIterator {
+	fetch := func(cursor string, size int) page {
+		// the lower bound is inclusive, so request the cursor item as well
+		limit := size
+		if cursor != "" && limit < AssetsMaxBatchSize {
+			limit++
+		}
+
+		assets, err := api.ListAssets(cursor, limit)
+		if err != nil {
+			return page{err: errors.Annotate(err, "ListAssets")}
+		}
+
+		pg := page{
+			items: make([]pageItem, 0, len(assets)),
+			last:  len(assets) < limit,
+			next:  cursor,
+		}
+
+		for _, asset := range assets {
+			symbol := asset.Symbol.String()
+			if cursor != "" && symbol == cursor {
+				continue
+			}
+
+			pg.items = append(pg.items, pageItem{
+				value:  asset,
+				cursor: symbol,
+			})
+
+			pg.next = symbol
+		}
+
+		return pg
+	}
+
+	return &AssetIterator{
+		pageIterator: newPageIterator(fetch, AssetsMaxBatchSize, opts),
+	}
+}
+
+//AccountIterator walks all registered accounts in alphabetical order of their names.
+type AccountIterator struct {
+	*pageIterator
+}
+
+//Value returns the current AccountLookup.
+func (p *AccountIterator) Value() *types.AccountLookup {
+	if p.value == nil {
+		return nil
+	}
+
+	acct := p.value.(types.AccountLookup)
+	return &acct
+}
+
+//NewAccountIterator creates an iterator over all accounts. Cursors are account names.
+func NewAccountIterator(api WebsocketAPI, opts *IteratorOptions) *AccountIterator {
+	fetch := func(cursor string, size int) page {
+		// the lower bound is inclusive, so request the cursor item as well
+		limit := size
+		if cursor != "" && limit < LookupAccountsLimit {
+			limit++
+		}
+
+		accts, err := api.LookupAccounts(cursor, limit)
+		if err != nil {
+			return page{err: errors.Annotate(err, "LookupAccounts")}
+		}
+
+		pg := page{
+			items: make([]pageItem, 0, len(accts)),
+			last:  len(accts) < limit,
+			next:  cursor,
+		}
+
+		for _, acct := range accts {
+			name := acct.Name.String()
+			if cursor != "" && name == cursor {
+				continue
+			}
+
+			pg.items = append(pg.items, pageItem{
+				value:  acct,
+				cursor: name,
+			})
+
+			pg.next = name
+		}
+
+		return pg
+	}
+
+	return &AccountIterator{
+		pageIterator: newPageIterator(fetch, LookupAccountsLimit, opts),
+	}
+}
+
+//LimitOrderIterator walks all limit orders of a market. Orders selling base
+//are delivered first, followed by orders selling quote, each side best price first.
+type LimitOrderIterator struct {
+	*pageIterator
+}
+
+//Value returns the current LimitOrder.
+func (p *LimitOrderIterator) Value() *types.LimitOrder {
+	if p.value == nil {
+		return nil
+	}
+
+	order := p.value.(types.LimitOrder)
+	return &order
+}
+
+//NewLimitOrderIterator creates an iterator over all limit orders of the market base:quote.
+//get_limit_orders has no start parameter, so each page widens the requested depth up to the
+//node's limit GetLimitOrdersMaxDepth. Deeper markets are cut off there, each side stops after
+//its best GetLimitOrdersMaxDepth orders. Cursors are order positions and orders may move between pages, because the book changes while iterating.
+func NewLimitOrderIterator(api WebsocketAPI, base, quote types.GrapheneObject, opts *IteratorOptions) *LimitOrderIterator {
+	fetch := func(cursor string, size int) page {
+		var offset int
+		if cursor != "" {
+			o, err := strconv.Atoi(cursor)
+			if err != nil {
+				return page{err: errors.Annotate(err, "Atoi [cursor]")}
+			}
+			offset = o
+		}
+
+		depth := offset + size
+		capped := depth >= GetLimitOrdersMaxDepth
+		if capped {
+			depth = GetLimitOrdersMaxDepth
+		}
+
+		resp, err := api.CallWsAPI(api.DatabaseAPIID(), "get_limit_orders",
+			base.ID(), quote.ID(), depth,
+		)
+		if err != nil {
+			return page{err: errors.Annotate(err, "CallWsAPI")}
+		}
+
+		orders := types.LimitOrders{}
+		if err := ffjson.Unmarshal(*resp, &orders); err != nil {
+			return page{err: errors.Annotate(err, "Unmarshal [LimitOrders]")}
+		}
+
+		var asks, bids types.LimitOrders
+		for _, order := range orders {
+			if order.SellPrice.Base.Asset.Equals(base) {
+				asks = append(asks, order)
+			} else {
+				bids = append(bids, order)
+			}
+		}
+
+		// a side is complete if the node returned less than requested
+		// or no deeper request is accepted
+		asksComplete := capped || len(asks) < depth
+		bidsComplete := capped || len(bids) < depth
+
+		all := asks
+		if asksComplete {
+			all = append(all, bids...)
+		}
+
+		pg := page{
+			last: asksComplete && bidsComplete,
+			next: cursor,
+		}
+
+		for idx := offset; idx < len(all); idx++ {
+			pg.items = append(pg.items, pageItem{
+				value:  all[idx],
+				cursor: strconv.Itoa(idx + 1),
+			})
+
+			pg.next = strconv.Itoa(idx + 1)
+		}
+
+		return pg
+	}
+
+	return &LimitOrderIterator{
+		pageIterator: newPageIterator(fetch, GetLimitOrdersLimit, opts),
+	}
+}
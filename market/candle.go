@@ -0,0 +1,375 @@
+package market
+
+import (
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/denkhaus/bitshares"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
+)
+
+//Candle is an OHLCV candle of a Market. Prices are in base per quote,
+//volumes are in asset units of the respective asset.
+type Candle struct {
+	Open        time.Time
+	Interval    time.Duration
+	OpenPrice   *big.Rat
+	High        *big.Rat
+	Low         *big.Rat
+	Close       *big.Rat
+	BaseVolume  *big.Rat
+	QuoteVolume *big.Rat
+	//Trades is the number of trades aggregated, zero for buckets and gap candles.
+	Trades int
+	//Filled is true if the candle has no trades and carries the previous close.
+	Filled bool
+
+	first time.Time
+	last  time.Time
+}
+
+//End returns the exclusive end time of the candle.
+func (p Candle) End() time.Time {
+	return p.Open.Add(p.Interval)
+}
+
+func (p Candle) clone() Candle {
+	ret := p
+	ret.OpenPrice = new(big.Rat).Set(p.OpenPrice)
+	ret.High = new(big.Rat).Set(p.High)
+	ret.Low = new(big.Rat).Set(p.Low)
+	ret.Close = new(big.Rat).Set(p.Close)
+	ret.BaseVolume = new(big.Rat).Set(p.BaseVolume)
+	ret.QuoteVolume = new(big.Rat).Set(p.QuoteVolume)
+	return ret
+}
+
+//merge adds a trade or bucket at tm. Trades and buckets may arrive in any order.
+func (p *Candle) merge(tm time.Time, open, high, low, cls, baseVolume, quoteVolume *big.Rat) {
+	if p.OpenPrice == nil {
+		p.OpenPrice = new(big.Rat).Set(open)
+		p.High = new(big.Rat).Set(high)
+		p.Low = new(big.Rat).Set(low)
+		p.Close = new(big.Rat).Set(cls)
+		p.BaseVolume = new(big.Rat)
+		p.QuoteVolume = new(big.Rat)
+		p.first, p.last = tm, tm
+	}
+
+	if tm.Before(p.first) {
+		p.OpenPrice.Set(open)
+		p.first = tm
+	}
+	if !tm.Before(p.last) {
+		p.Close.Set(cls)
+		p.last = tm
+	}
+
+	if high.Cmp(p.High) > 0 {
+		p.High.Set(high)
+	}
+	if low.Cmp(p.Low) < 0 {
+		p.Low.Set(low)
+	}
+
+	p.BaseVolume.Add(p.BaseVolume, baseVolume)
+	p.QuoteVolume.Add(p.QuoteVolume, quoteVolume)
+}
+
+type Candles []Candle
+
+//CandleFunc is called with a copy of a candle whenever it changes.
+type CandleFunc func(candle Candle)
+
+//Aggregator aggregates trades and market history buckets
+//into candles of a fixed interval. It is safe for concurrent use.
+type Aggregator struct {
+	market   Market
+	interval time.Duration
+	mu       sync.Mutex
+	candles  map[int64]*Candle
+	onUpdate CandleFunc
+	now      func() time.Time
+}
+
+//NewAggregator creates an Aggregator for market with candles of the given interval.
+func NewAggregator(market Market, interval time.Duration) (*Aggregator, error) {
+	if interval < time.Second || interval%time.Second != 0 {
+		return nil, ErrInvalidInterval
+	}
+
+	return &Aggregator{
+		market:   market,
+		interval: interval,
+		candles:  make(map[int64]*Candle),
+		now:      time.Now,
+	}, nil
+}
+
+//Interval returns the candle interval.
+func (p *Aggregator) Interval() time.Duration {
+	return p.interval
+}
+
+//OnUpdate registers fn to be called whenever a live fill changes a candle.
+func (p *Aggregator) OnUpdate(fn CandleFunc) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.onUpdate = fn
+}
+
+func (p *Aggregator) slot(tm time.Time) *Candle {
+	open := tm.UTC().Truncate(p.interval)
+	key := open.Unix()
+
+	candle, ok := p.candles[key]
+	if !ok {
+		candle = &Candle{
+			Open:     open,
+			Interval: p.interval,
+		}
+		p.candles[key] = candle
+	}
+
+	return candle
+}
+
+func (p *Aggregator) addTrade(tm time.Time, base, quote int64) (*Candle, error) {
+	if base <= 0 || quote <= 0 {
+		return nil, errors.Errorf("invalid trade amounts %d:%d", base, quote)
+	}
+
+	price := p.market.Price(base, quote)
+	candle := p.slot(tm)
+	candle.merge(tm, price, price, price, price,
+		p.market.BaseAmount(base), p.market.QuoteAmount(quote),
+	)
+	candle.Trades++
+
+	return candle, nil
+}
+
+//AddFill adds a trade at tm given by the amounts paid and received by one side.
+func (p *Aggregator) AddFill(tm time.Time, pays, receives types.AssetAmount) error {
+	base, quote, err := p.market.Split(pays, receives)
+	if err != nil {
+		return errors.Annotate(err, "Split")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, err := p.addTrade(tm, base, quote); err != nil {
+		return errors.Annotate(err, "addTrade")
+	}
+
+	return nil
+}
+
+//AddTrade adds a trade as returned by GetTradeHistory for the market base:quote.
+//The float amounts are rounded to the asset precisions and the price is derived from them.
+func (p *Aggregator) AddTrade(trade types.MarketTrade) error {
+	base := rawAmount(float64(trade.Value), p.market.Base.Precision)
+	quote := rawAmount(float64(trade.Amount), p.market.Quote.Precision)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, err := p.addTrade(trade.DateTime.Time, base, quote); err != nil {
+		return errors.Annotate(err, "addTrade")
+	}
+
+	return nil
+}
+
+//AddBucket adds a market history bucket. The bucket size must divide the candle interval.
+func (p *Aggregator) AddBucket(bucket types.MarketBucket) error {
+	size := time.Duration(bucket.Key.Seconds) * time.Second
+	if size == 0 || p.interval%size != 0 {
+		return errors.Annotatef(ErrInvalidInterval, "bucket size %s", size)
+	}
+
+	var (
+		openBase, openQuote   = int64(bucket.OpenBase), int64(bucket.OpenQuote)
+		highBase, highQuote   = int64(bucket.HighBase), int64(bucket.HighQuote)
+		lowBase, lowQuote     = int64(bucket.LowBase), int64(bucket.LowQuote)
+		closeBase, closeQuote = int64(bucket.CloseBase), int64(bucket.CloseQuote)
+		baseVolume            = int64(bucket.BaseVolume)
+		quoteVolume           = int64(bucket.QuoteVolume)
+	)
+
+	switch {
+	case p.market.Base.ID.Equals(&bucket.Key.Base) && p.market.Quote.ID.Equals(&bucket.Key.Quote):
+	case p.market.Base.ID.Equals(&bucket.Key.Quote) && p.market.Quote.ID.Equals(&bucket.Key.Base):
+		// buckets are keyed by ascending asset id, so invert the bucket
+		openBase, openQuote = openQuote, openBase
+		highBase, highQuote, lowBase, lowQuote = lowQuote, lowBase, highQuote, highBase
+		closeBase, closeQuote = closeQuote, closeBase
+		baseVolume, quoteVolume = quoteVolume, baseVolume
+	default:
+		return ErrAssetNotInMarket
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	candle := p.slot(bucket.Key.Open.Time)
+	candle.merge(bucket.Key.Open.Time,
+		p.market.Price(openBase, openQuote),
+		p.market.Price(highBase, highQuote),
+		p.market.Price(lowBase, lowQuote),
+		p.market.Price(closeBase, closeQuote),
+		p.market.BaseAmount(baseVolume),
+		p.market.QuoteAmount(quoteVolume),
+	)
+
+	return nil
+}
+
+//Subscribe keeps the candles up to date with the fills of the market.
+//Live fills carry no timestamp, so they are placed at the time of reception.
+func (p *Aggregator) Subscribe(api bitshares.WebsocketAPI) error {
+	if err := api.SubscribeToMarket(&p.market.Base.ID, &p.market.Quote.ID, p.HandleNotice); err != nil {
+		return errors.Annotate(err, "SubscribeToMarket")
+	}
+
+	return nil
+}
+
+//Unsubscribe stops the live update of the candles.
+func (p *Aggregator) Unsubscribe(api bitshares.WebsocketAPI) error {
+	if err := api.UnsubscribeFromMarket(&p.market.Base.ID, &p.market.Quote.ID); err != nil {
+		return errors.Annotate(err, "UnsubscribeFromMarket")
+	}
+
+	return nil
+}
+
+//HandleNotice applies a subscribe_to_market notification. Only maker fills are
+//counted, because every match emits a fill for both the maker and the taker.
+func (p *Aggregator) HandleNotice(msg interface{}) error {
+	notice, err := DecodeNotice(msg)
+	if err != nil {
+		return errors.Annotate(err, "DecodeNotice")
+	}
+
+	p.mu.Lock()
+	tm := p.now()
+	updated := make(map[int64]*Candle)
+	for _, fill := range notice.Fills {
+		if !fill.IsMaker {
+			continue
+		}
+
+		base, quote, err := p.market.Split(fill.Pays, fill.Receives)
+		if err != nil {
+			continue
+		}
+
+		candle, err := p.addTrade(tm, base, quote)
+		if err != nil {
+			p.mu.Unlock()
+			return errors.Annotate(err, "addTrade")
+		}
+
+		updated[candle.Open.Unix()] = candle
+	}
+
+	fn := p.onUpdate
+	candles := make([]Candle, 0, len(updated))
+	for _, candle := range updated {
+		candles = append(candles, candle.clone())
+	}
+	p.mu.Unlock()
+
+	if fn != nil {
+		for _, candle := range candles {
+			fn(candle)
+		}
+	}
+
+	return nil
+}
+
+//Latest returns the most recent candle.
+func (p *Aggregator) Latest() (Candle, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var latest *Candle
+	for _, candle := range p.candles {
+		if latest == nil || candle.Open.After(latest.Open) {
+			latest = candle
+		}
+	}
+
+	if latest == nil {
+		return Candle{}, false
+	}
+
+	return latest.clone(), true
+}
+
+//Candles returns the candles opened within [from, to) in ascending order.
+//If fillGaps is true, intervals without trades after the first candle
+//are returned as Filled candles carrying the previous close.
+func (p *Aggregator) Candles(from, to time.Time, fillGaps bool) Candles {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	from = from.UTC().Truncate(p.interval)
+	keys := make([]int64, 0, len(p.candles))
+	for key, candle := range p.candles {
+		if !candle.Open.Before(from) && candle.Open.Before(to) {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i] < keys[j]
+	})
+
+	ret := make(Candles, 0, len(keys))
+	for _, key := range keys {
+		candle := p.candles[key]
+		if fillGaps && len(ret) > 0 {
+			ret = p.fill(ret, candle.Open)
+		}
+
+		ret = append(ret, candle.clone())
+	}
+
+	if fillGaps && len(ret) > 0 {
+		end := to.UTC().Truncate(p.interval)
+		if end.Before(to) {
+			end = end.Add(p.interval)
+		}
+
+		ret = p.fill(ret, end)
+	}
+
+	return ret
+}
+
+//fill appends gap candles to candles up to the candle opened at until.
+func (p *Aggregator) fill(candles Candles, until time.Time) Candles {
+	prev := candles[len(candles)-1]
+	for open := prev.End(); open.Before(until); open = open.Add(p.interval) {
+		candles = append(candles, Candle{
+			Open:        open,
+			Interval:    p.interval,
+			OpenPrice:   new(big.Rat).Set(prev.Close),
+			High:        new(big.Rat).Set(prev.Close),
+			Low:         new(big.Rat).Set(prev.Close),
+			Close:       new(big.Rat).Set(prev.Close),
+			BaseVolume:  new(big.Rat),
+			QuoteVolume: new(big.Rat),
+			Filled:      true,
+		})
+	}
+
+	return candles
+}
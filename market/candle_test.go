@@ -0,0 +1,192 @@
+package market
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/denkhaus/bitshares/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func testMarket() Market {
+	// CNY:BTS, CNY has 4 and BTS has 5 decimals
+	return Market{
+		Base: types.Asset{
+			ID:        types.AssetIDFromObject(types.NewAssetID("1.3.113")),
+			Precision: 4,
+		},
+		Quote: types.Asset{
+			ID:        types.AssetIDFromObject(types.NewAssetID("1.3.0")),
+			Precision: 5,
+		},
+	}
+}
+
+func amount(asset string, amount int64) types.AssetAmount {
+	return types.AssetAmount{
+		Asset:  types.AssetIDFromObject(types.NewAssetID(asset)),
+		Amount: types.Int64(amount),
+	}
+}
+
+func TestMarketPrice(t *testing.T) {
+	mkt := testMarket()
+
+	// 1 CNY for 3 BTS
+	price := mkt.Price(10000, 300000)
+	assert.Equal(t, "1/3", price.RatString())
+
+	base, quote, err := mkt.Split(amount("1.3.0", 300000), amount("1.3.113", 10000))
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(10000), base)
+		assert.Equal(t, int64(300000), quote)
+	}
+
+	_, _, err = mkt.Split(amount("1.3.0", 1), amount("1.3.1", 1))
+	assert.Error(t, err)
+}
+
+func TestAggregatorFills(t *testing.T) {
+	agg, err := NewAggregator(testMarket(), time.Minute)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	start := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	fills := []struct {
+		offset time.Duration
+		base   int64
+		quote  int64
+	}{
+		{30 * time.Second, 20000, 1000000}, // 0.2
+		{10 * time.Second, 10000, 1000000}, // 0.1, earliest
+		{50 * time.Second, 30000, 1000000}, // 0.3, latest
+		{3 * time.Minute, 40000, 1000000},  // 0.4
+	}
+
+	for _, fill := range fills {
+		assert.NoError(t, agg.AddFill(start.Add(fill.offset),
+			amount("1.3.113", fill.base), amount("1.3.0", fill.quote),
+		))
+	}
+
+	candles := agg.Candles(start, start.Add(5*time.Minute), true)
+	if !assert.Len(t, candles, 5) {
+		return
+	}
+
+	first := candles[0]
+	assert.Equal(t, "1/10", first.OpenPrice.RatString())
+	assert.Equal(t, "3/10", first.High.RatString())
+	assert.Equal(t, "1/10", first.Low.RatString())
+	assert.Equal(t, "3/10", first.Close.RatString())
+	assert.Equal(t, "6", first.BaseVolume.RatString())
+	assert.Equal(t, "30", first.QuoteVolume.RatString())
+	assert.Equal(t, 3, first.Trades)
+
+	for _, gap := range candles[1:3] {
+		assert.True(t, gap.Filled)
+		assert.Equal(t, "3/10", gap.Close.RatString())
+		assert.Equal(t, 0, gap.BaseVolume.Sign())
+	}
+
+	assert.Equal(t, "2/5", candles[3].Close.RatString())
+	assert.True(t, candles[4].Filled)
+
+	latest, ok := agg.Latest()
+	assert.True(t, ok)
+	assert.Equal(t, start.Add(3*time.Minute), latest.Open)
+}
+
+func TestAggregatorBuckets(t *testing.T) {
+	agg, err := NewAggregator(testMarket(), 2*time.Minute)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	start := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// buckets are keyed BTS:CNY, so base and quote are inverted
+	bucket := func(offset time.Duration, low, high int64) types.MarketBucket {
+		return types.MarketBucket{
+			Key: types.MarketBucketKey{
+				Base:    types.AssetIDFromObject(types.NewAssetID("1.3.0")),
+				Quote:   types.AssetIDFromObject(types.NewAssetID("1.3.113")),
+				Seconds: 60,
+				Open:    types.Time{Time: start.Add(offset)},
+			},
+			HighBase: 100000, HighQuote: types.Int64(low),
+			LowBase: 100000, LowQuote: types.Int64(high),
+			OpenBase: 100000, OpenQuote: types.Int64(low),
+			CloseBase: 100000, CloseQuote: types.Int64(high),
+			BaseVolume: 500000, QuoteVolume: 100000,
+		}
+	}
+
+	assert.NoError(t, agg.AddBucket(bucket(time.Minute, 20000, 30000)))
+	assert.NoError(t, agg.AddBucket(bucket(0, 10000, 20000)))
+	assert.Error(t, agg.AddBucket(types.MarketBucket{
+		Key: types.MarketBucketKey{Seconds: 300},
+	}))
+
+	candles := agg.Candles(start, start.Add(2*time.Minute), false)
+	if !assert.Len(t, candles, 1) {
+		return
+	}
+
+	candle := candles[0]
+	// the inverted high of BTS:CNY is the low of CNY:BTS
+	assert.Equal(t, "1", candle.OpenPrice.RatString())
+	assert.Equal(t, "3", candle.High.RatString())
+	assert.Equal(t, "1", candle.Low.RatString())
+	assert.Equal(t, "3", candle.Close.RatString())
+	assert.Equal(t, 0, candle.BaseVolume.Cmp(big.NewRat(20, 1)))
+	assert.Equal(t, 0, candle.QuoteVolume.Cmp(big.NewRat(10, 1)))
+}
+
+func TestAggregatorNotice(t *testing.T) {
+	agg, err := NewAggregator(testMarket(), time.Minute)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	now := time.Date(2019, 1, 1, 0, 0, 30, 0, time.UTC)
+	agg.now = func() time.Time { return now }
+
+	var updates Candles
+	agg.OnUpdate(func(candle Candle) {
+		updates = append(updates, candle)
+	})
+
+	fill := func(isMaker bool) interface{} {
+		return []interface{}{
+			float64(4), map[string]interface{}{
+				"fee":        map[string]interface{}{"amount": 0, "asset_id": "1.3.0"},
+				"order_id":   "1.7.1",
+				"account_id": "1.2.1",
+				"pays":       map[string]interface{}{"amount": 50000, "asset_id": "1.3.113"},
+				"receives":   map[string]interface{}{"amount": 100000, "asset_id": "1.3.0"},
+				"is_maker":   isMaker,
+			},
+		}
+	}
+
+	msg := []interface{}{
+		[]interface{}{
+			[]interface{}{fill(true), []interface{}{float64(0), map[string]interface{}{}}},
+			[]interface{}{fill(false), []interface{}{float64(0), map[string]interface{}{}}},
+		},
+	}
+
+	notice, err := DecodeNotice(msg)
+	if assert.NoError(t, err) {
+		assert.Len(t, notice.Fills, 2)
+	}
+
+	assert.NoError(t, agg.HandleNotice(msg))
+	if assert.Len(t, updates, 1) {
+		assert.Equal(t, "5", updates[0].Close.RatString())
+		assert.Equal(t, 1, updates[0].Trades)
+	}
+}
@@ -0,0 +1,135 @@
+package market
+
+import (
+	"time"
+
+	"github.com/denkhaus/bitshares"
+	"github.com/juju/errors"
+)
+
+const (
+	//MarketHistoryLimit is the maximum number of buckets get_market_history returns per call.
+	MarketHistoryLimit = 200
+)
+
+//FetchCandles returns the candles of market within [start, end) with gaps filled.
+//It uses market history buckets if a tracked bucket size divides interval
+//and falls back to aggregating the raw trade history otherwise.
+func FetchCandles(api bitshares.WebsocketAPI, market Market, interval time.Duration, start, end time.Time) (Candles, error) {
+	agg, err := NewAggregator(market, interval)
+	if err != nil {
+		return nil, errors.Annotate(err, "NewAggregator")
+	}
+
+	sizes, err := api.GetMarketHistoryBuckets()
+	if err != nil {
+		return nil, errors.Annotate(err, "GetMarketHistoryBuckets")
+	}
+
+	var bucketSize uint32
+	for _, size := range sizes {
+		dur := time.Duration(size) * time.Second
+		if size > bucketSize && dur <= interval && interval%dur == 0 {
+			bucketSize = size
+		}
+	}
+
+	if bucketSize > 0 {
+		err = agg.LoadBuckets(api, bucketSize, start, end)
+	} else {
+		err = agg.LoadTrades(api, start, end)
+	}
+
+	if err != nil {
+		return nil, errors.Annotate(err, "Load")
+	}
+
+	return agg.Candles(start, end, true), nil
+}
+
+//LoadBuckets adds all market history buckets of bucketSize seconds within [start, end).
+func (p *Aggregator) LoadBuckets(api bitshares.WebsocketAPI, bucketSize uint32, start, end time.Time) error {
+	size := time.Duration(bucketSize) * time.Second
+	for start.Before(end) {
+		buckets, err := api.GetMarketHistory(&p.market.Base.ID, &p.market.Quote.ID,
+			bucketSize, start, end,
+		)
+		if err != nil {
+			return errors.Annotate(err, "GetMarketHistory")
+		}
+
+		for _, bucket := range buckets {
+			if !bucket.Key.Open.Before(end) {
+				continue
+			}
+
+			if err := p.AddBucket(bucket); err != nil {
+				return errors.Annotate(err, "AddBucket")
+			}
+		}
+
+		if len(buckets) < MarketHistoryLimit {
+			break
+		}
+
+		start = buckets[len(buckets)-1].Key.Open.Add(size).Time
+	}
+
+	return nil
+}
+
+//LoadTrades adds all trades of the trade history within [start, end).
+func (p *Aggregator) LoadTrades(api bitshares.WebsocketAPI, start, end time.Time) error {
+	to := end.UTC().Truncate(time.Second)
+	from := start.UTC().Truncate(time.Second)
+
+	// trades are delivered most recent first and only carry second
+	// resolution, so skip the trades of the boundary second already seen
+	var skip int
+	for {
+		trades, err := api.GetTradeHistory(&p.market.Base.ID, &p.market.Quote.ID,
+			to, from, bitshares.GetTradeHistoryLimit,
+		)
+		if err != nil {
+			return errors.Annotate(err, "GetTradeHistory")
+		}
+
+		for idx, trade := range trades {
+			tm := trade.DateTime.Time
+			if tm.Equal(to) && idx < skip {
+				continue
+			}
+
+			if !tm.Before(end) || tm.Before(start) {
+				continue
+			}
+
+			if err := p.AddTrade(trade); err != nil {
+				return errors.Annotate(err, "AddTrade")
+			}
+		}
+
+		if len(trades) < bitshares.GetTradeHistoryLimit {
+			break
+		}
+
+		oldest := trades[len(trades)-1].DateTime.Time
+		if oldest.Equal(to) {
+			// a full page within one second can not be paged any further
+			to = to.Add(-time.Second)
+			skip = 0
+		} else {
+			skip = 0
+			for idx := len(trades) - 1; idx >= 0 && trades[idx].DateTime.Time.Equal(oldest); idx-- {
+				skip++
+			}
+			to = oldest
+		}
+
+		if to.Before(from) {
+			break
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package market
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/denkhaus/bitshares"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
+)
+
+var (
+	ErrInvalidMarket    = errors.New("invalid market")
+	ErrInvalidInterval  = errors.New("invalid interval")
+	ErrAssetNotInMarket = errors.New("asset not part of market")
+)
+
+//Market describes the trading pair base:quote. Prices are expressed
+//in units of the base asset per unit of the quote asset.
+type Market struct {
+	Base  types.Asset
+	Quote types.Asset
+}
+
+//NewMarket looks up base and quote and returns the Market base:quote.
+func NewMarket(api bitshares.WebsocketAPI, base, quote types.GrapheneObject) (*Market, error) {
+	assets, err := api.LookupAssetSymbols(base.ID(), quote.ID())
+	if err != nil {
+		return nil, errors.Annotate(err, "LookupAssetSymbols")
+	}
+
+	if len(assets) != 2 || !assets[0].ID.Valid() || !assets[1].ID.Valid() {
+		return nil, ErrInvalidMarket
+	}
+
+	return &Market{
+		Base:  assets[0],
+		Quote: assets[1],
+	}, nil
+}
+
+//Contains returns true if asset is either base or quote of the market.
+func (p Market) Contains(asset types.GrapheneObject) bool {
+	return p.Base.ID.Equals(asset) || p.Quote.ID.Equals(asset)
+}
+
+//Price returns the exact price of baseAmount against quoteAmount, both raw integer amounts.
+func (p Market) Price(baseAmount, quoteAmount int64) *big.Rat {
+	if quoteAmount == 0 {
+		return new(big.Rat)
+	}
+
+	num := new(big.Int).Mul(big.NewInt(baseAmount), pow10(p.Quote.Precision))
+	den := new(big.Int).Mul(big.NewInt(quoteAmount), pow10(p.Base.Precision))
+	return new(big.Rat).SetFrac(num, den)
+}
+
+//BaseAmount converts a raw base asset amount into asset units.
+func (p Market) BaseAmount(amount int64) *big.Rat {
+	return new(big.Rat).SetFrac(big.NewInt(amount), pow10(p.Base.Precision))
+}
+
+//QuoteAmount converts a raw quote asset amount into asset units.
+func (p Market) QuoteAmount(amount int64) *big.Rat {
+	return new(big.Rat).SetFrac(big.NewInt(amount), pow10(p.Quote.Precision))
+}
+
+//Split orders the two legs of a trade in the market into its base and quote amount.
+func (p Market) Split(a, b types.AssetAmount) (base, quote int64, err error) {
+	switch {
+	case p.Base.ID.Equals(&a.Asset) && p.Quote.ID.Equals(&b.Asset):
+		return int64(a.Amount), int64(b.Amount), nil
+	case p.Quote.ID.Equals(&a.Asset) && p.Base.ID.Equals(&b.Asset):
+		return int64(b.Amount), int64(a.Amount), nil
+	}
+
+	return 0, 0, ErrAssetNotInMarket
+}
+
+//rawAmount converts a float amount in asset units into the raw integer amount.
+func rawAmount(amount float64, precision int) int64 {
+	return int64(math.Round(amount * math.Pow10(precision)))
+}
+
+func pow10(precision int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(precision)), nil)
+}
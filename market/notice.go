@@ -0,0 +1,103 @@
+package market
+
+import (
+	"github.com/denkhaus/bitshares/operations"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
+	"github.com/pquerna/ffjson/ffjson"
+)
+
+//Notice is the decoded content of a subscribe_to_market notification.
+type Notice struct {
+	//Fills are the fill order operations of the applied block.
+	Fills []operations.FillOrderOperation
+	//Orders are created or updated limit orders.
+	Orders types.LimitOrders
+	//Removed are the IDs of removed objects, e.g. filled or cancelled orders.
+	Removed []types.ObjectID
+}
+
+//DecodeNotice decodes the message passed to a SubscribeToMarket callback.
+func DecodeNotice(msg interface{}) (*Notice, error) {
+	notice := &Notice{}
+	if err := notice.decode(msg); err != nil {
+		return nil, errors.Annotate(err, "decode")
+	}
+
+	return notice, nil
+}
+
+func (p *Notice) decode(in interface{}) error {
+	var items []interface{}
+	switch v := in.(type) {
+	case []interface{}:
+		items = v
+	case map[string]interface{}:
+		return p.decodeObject(v)
+	case string:
+		var id types.ObjectID
+		if err := id.Parse(v); err != nil {
+			return errors.Annotate(err, "Parse [id]")
+		}
+
+		p.Removed = append(p.Removed, id)
+		return nil
+	default:
+		return nil
+	}
+
+	// operations are encoded as [type, {operation}]
+	if len(items) == 2 {
+		if typ, ok := items[0].(float64); ok {
+			if obj, ok := items[1].(map[string]interface{}); ok && types.OperationType(typ) == types.OperationTypeFillOrder {
+				return p.decodeFill(obj)
+			}
+		}
+	}
+
+	for _, item := range items {
+		if err := p.decode(item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *Notice) decodeFill(obj map[string]interface{}) error {
+	data, err := ffjson.Marshal(obj)
+	if err != nil {
+		return errors.Annotate(err, "Marshal [fill]")
+	}
+
+	var op operations.FillOrderOperation
+	if err := ffjson.Unmarshal(data, &op); err != nil {
+		return errors.Annotate(err, "Unmarshal [FillOrderOperation]")
+	}
+
+	p.Fills = append(p.Fills, op)
+	return nil
+}
+
+func (p *Notice) decodeObject(obj map[string]interface{}) error {
+	if _, ok := obj["sell_price"]; !ok {
+		return nil
+	}
+
+	if _, ok := obj["for_sale"]; !ok {
+		return nil
+	}
+
+	data, err := ffjson.Marshal(obj)
+	if err != nil {
+		return errors.Annotate(err, "Marshal [order]")
+	}
+
+	var order types.LimitOrder
+	if err := ffjson.Unmarshal(data, &order); err != nil {
+		return errors.Annotate(err, "Unmarshal [LimitOrder]")
+	}
+
+	p.Orders = append(p.Orders, order)
+	return nil
+}
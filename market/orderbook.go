@@ -0,0 +1,456 @@
+package market
+
+import (
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/denkhaus/bitshares"
+	"github.com/denkhaus/bitshares/api"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/denkhaus/logging"
+	"github.com/juju/errors"
+)
+
+const (
+	//DefaultCheckInterval is the default interval of the OrderBook connection and gap checks.
+	DefaultCheckInterval = time.Second
+)
+
+//PriceLevel aggregates all orders of one side at the same price.
+//Price is in base per quote, amounts are in asset units.
+type PriceLevel struct {
+	Price       *big.Rat
+	BaseAmount  *big.Rat
+	QuoteAmount *big.Rat
+	Orders      int
+}
+
+type PriceLevels []PriceLevel
+
+//OrderBookOptions configures an OrderBook.
+type OrderBookOptions struct {
+	//Depth is the number of orders per side seeded by GetLimitOrders.
+	//Zero or values above the node limit select the node limit.
+	Depth int
+	//CheckInterval is the interval of the connection and gap checks.
+	//Zero selects DefaultCheckInterval.
+	CheckInterval time.Duration
+	//ResyncInterval forces a periodic resynchronization. Zero disables it.
+	ResyncInterval time.Duration
+	//OnError receives errors of the background resynchronization.
+	OnError api.ErrorFunc
+}
+
+//OrderBook is a locally maintained limit order book of a Market. It is seeded
+//by GetLimitOrders and kept up to date by subscribe_to_market notifications.
+//Because notices carry no sequence numbers, removals of unknown orders are
+//treated as gaps and trigger a resynchronization, as does a reconnect.
+type OrderBook struct {
+	api      bitshares.WebsocketAPI
+	market   Market
+	opts     OrderBookOptions
+	syncMu   sync.Mutex
+	mu       sync.RWMutex
+	orders   map[string]types.LimitOrder
+	bids     int
+	asks     int
+	bidsFull bool
+	asksFull bool
+	synced   bool
+	seeding  bool
+	pending  []*Notice
+	onUpdate func()
+	trigger  chan struct{}
+	done     chan struct{}
+	wg       sync.WaitGroup
+}
+
+//NewOrderBook creates an OrderBook for market. Call Start to seed and subscribe.
+func NewOrderBook(api bitshares.WebsocketAPI, market Market, opts *OrderBookOptions) *OrderBook {
+	if opts == nil {
+		opts = &OrderBookOptions{}
+	}
+
+	book := &OrderBook{
+		api:     api,
+		market:  market,
+		opts:    *opts,
+		orders:  make(map[string]types.LimitOrder),
+		trigger: make(chan struct{}, 1),
+	}
+
+	if book.opts.Depth <= 0 || book.opts.Depth > bitshares.GetLimitOrdersLimit {
+		book.opts.Depth = bitshares.GetLimitOrdersLimit
+	}
+
+	if book.opts.CheckInterval <= 0 {
+		book.opts.CheckInterval = DefaultCheckInterval
+	}
+
+	return book
+}
+
+//OnUpdate registers fn to be called after the book changed.
+func (p *OrderBook) OnUpdate(fn func()) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.onUpdate = fn
+}
+
+//Start subscribes to the market, seeds the book and starts the background checks.
+func (p *OrderBook) Start() error {
+	if err := p.subscribe(); err != nil {
+		return errors.Annotate(err, "subscribe")
+	}
+
+	if err := p.Resync(); err != nil {
+		return errors.Annotate(err, "Resync")
+	}
+
+	p.done = make(chan struct{})
+	p.wg.Add(1)
+	go p.run()
+
+	return nil
+}
+
+//Stop ends the background checks and unsubscribes from the market.
+func (p *OrderBook) Stop() error {
+	if p.done != nil {
+		close(p.done)
+		p.wg.Wait()
+		p.done = nil
+	}
+
+	p.mu.Lock()
+	p.synced = false
+	p.mu.Unlock()
+
+	if p.api.IsConnected() {
+		if err := p.api.UnsubscribeFromMarket(&p.market.Base.ID, &p.market.Quote.ID); err != nil {
+			return errors.Annotate(err, "UnsubscribeFromMarket")
+		}
+	}
+
+	return nil
+}
+
+func (p *OrderBook) subscribe() error {
+	return p.api.SubscribeToMarket(&p.market.Base.ID, &p.market.Quote.ID, p.HandleNotice)
+}
+
+func (p *OrderBook) run() {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(p.opts.CheckInterval)
+	defer ticker.Stop()
+
+	lastSync := time.Now()
+	disconnected := false
+
+	for {
+		select {
+		case <-p.done:
+			return
+		case <-p.trigger:
+		case <-ticker.C:
+		}
+
+		if !p.api.IsConnected() {
+			p.invalidate()
+			disconnected = true
+
+			// any api call lets the client provider reconnect
+			if _, err := p.api.GetChainID(); err != nil {
+				p.report(errors.Annotate(err, "GetChainID"))
+				continue
+			}
+		}
+
+		if disconnected {
+			// subscriptions do not survive a reconnect
+			if err := p.subscribe(); err != nil {
+				p.report(errors.Annotate(err, "subscribe"))
+				continue
+			}
+
+			disconnected = false
+		}
+
+		if p.Synced() && (p.opts.ResyncInterval <= 0 || time.Since(lastSync) < p.opts.ResyncInterval) {
+			continue
+		}
+
+		if err := p.Resync(); err != nil {
+			p.report(errors.Annotate(err, "Resync"))
+			continue
+		}
+
+		lastSync = time.Now()
+	}
+}
+
+func (p *OrderBook) report(err error) {
+	if p.opts.OnError != nil {
+		p.opts.OnError(err)
+		return
+	}
+
+	logging.Errorf("OrderBook error: %s", err)
+}
+
+func (p *OrderBook) invalidate() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.synced = false
+}
+
+func (p *OrderBook) requestResync() {
+	p.synced = false
+
+	select {
+	case p.trigger <- struct{}{}:
+	default:
+	}
+}
+
+//Resync reseeds the book from GetLimitOrders. Notices received meanwhile are applied afterwards.
+func (p *OrderBook) Resync() error {
+	p.syncMu.Lock()
+	defer p.syncMu.Unlock()
+
+	p.mu.Lock()
+	p.seeding = true
+	p.pending = nil
+	p.mu.Unlock()
+
+	orders, err := p.api.GetLimitOrders(&p.market.Base.ID, &p.market.Quote.ID, p.opts.Depth)
+	if err != nil {
+		p.mu.Lock()
+		p.seeding = false
+		p.synced = false
+		p.pending = nil
+		p.mu.Unlock()
+		return errors.Annotate(err, "GetLimitOrders")
+	}
+
+	p.mu.Lock()
+	p.orders = make(map[string]types.LimitOrder, len(orders))
+	p.bids, p.asks = 0, 0
+	for _, order := range orders {
+		p.insert(order)
+	}
+
+	p.bidsFull = p.bids >= p.opts.Depth
+	p.asksFull = p.asks >= p.opts.Depth
+	p.seeding = false
+	p.synced = true
+
+	pending := p.pending
+	p.pending = nil
+	for _, notice := range pending {
+		p.apply(notice)
+	}
+
+	fn := p.onUpdate
+	p.mu.Unlock()
+
+	if fn != nil {
+		fn()
+	}
+
+	return nil
+}
+
+//HandleNotice applies a subscribe_to_market notification.
+func (p *OrderBook) HandleNotice(msg interface{}) error {
+	notice, err := DecodeNotice(msg)
+	if err != nil {
+		return errors.Annotate(err, "DecodeNotice")
+	}
+
+	p.mu.Lock()
+	if p.seeding {
+		p.pending = append(p.pending, notice)
+		p.mu.Unlock()
+		return nil
+	}
+
+	p.apply(notice)
+	fn := p.onUpdate
+	p.mu.Unlock()
+
+	if fn != nil {
+		fn()
+	}
+
+	return nil
+}
+
+func (p *OrderBook) isBid(order types.LimitOrder) bool {
+	return p.market.Base.ID.Equals(&order.SellPrice.Base.Asset)
+}
+
+func (p *OrderBook) insert(order types.LimitOrder) {
+	if !p.market.Contains(&order.SellPrice.Base.Asset) || !p.market.Contains(&order.SellPrice.Quote.Asset) {
+		return
+	}
+
+	id := order.ID.ID()
+	if _, ok := p.orders[id]; !ok {
+		if p.isBid(order) {
+			p.bids++
+		} else {
+			p.asks++
+		}
+	}
+
+	p.orders[id] = order
+}
+
+func (p *OrderBook) remove(id string) bool {
+	order, ok := p.orders[id]
+	if !ok {
+		return false
+	}
+
+	if p.isBid(order) {
+		p.bids--
+	} else {
+		p.asks--
+	}
+
+	delete(p.orders, id)
+	return true
+}
+
+//apply updates the book by notice. Updated orders carry their full state,
+//so only the removal of an unknown order reveals a gap. Orders beyond the
+//seeded depth are unknown, so this is only detectable if the book holds every order.
+func (p *OrderBook) apply(notice *Notice) {
+	complete := !p.bidsFull && !p.asksFull
+
+	for _, order := range notice.Orders {
+		p.insert(order)
+	}
+
+	for _, id := range notice.Removed {
+		if id.ObjectType() != types.ObjectTypeLimitOrder {
+			continue
+		}
+
+		if !p.remove(id.ID()) && complete {
+			p.requestResync()
+		}
+	}
+
+	// a truncated side thins out while its best orders are filled
+	if (p.bidsFull && p.bids < p.opts.Depth/2) || (p.asksFull && p.asks < p.opts.Depth/2) {
+		p.requestResync()
+	}
+}
+
+//Synced returns true if the book is seeded and no gap has been detected since.
+func (p *OrderBook) Synced() bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.synced
+}
+
+//Orders returns a copy of all limit orders currently in the book.
+func (p *OrderBook) Orders() types.LimitOrders {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	ret := make(types.LimitOrders, 0, len(p.orders))
+	for _, order := range p.orders {
+		ret = append(ret, order)
+	}
+
+	return ret
+}
+
+//Bids returns the price levels of orders selling base, best price first.
+func (p *OrderBook) Bids() PriceLevels {
+	return p.levels(true)
+}
+
+//Asks returns the price levels of orders selling quote, best price first.
+func (p *OrderBook) Asks() PriceLevels {
+	return p.levels(false)
+}
+
+//BestBid returns the highest bid price level.
+func (p *OrderBook) BestBid() (PriceLevel, bool) {
+	return first(p.Bids())
+}
+
+//BestAsk returns the lowest ask price level.
+func (p *OrderBook) BestAsk() (PriceLevel, bool) {
+	return first(p.Asks())
+}
+
+func first(levels PriceLevels) (PriceLevel, bool) {
+	if len(levels) == 0 {
+		return PriceLevel{}, false
+	}
+
+	return levels[0], true
+}
+
+func (p *OrderBook) levels(bids bool) PriceLevels {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	byPrice := make(map[string]*PriceLevel)
+	for _, order := range p.orders {
+		if p.isBid(order) != bids {
+			continue
+		}
+
+		var price, base, quote *big.Rat
+		if bids {
+			price = p.market.Price(int64(order.SellPrice.Base.Amount), int64(order.SellPrice.Quote.Amount))
+			base = p.market.BaseAmount(int64(order.ForSale))
+			quote = new(big.Rat)
+			if price.Sign() > 0 {
+				quote.Quo(base, price)
+			}
+		} else {
+			price = p.market.Price(int64(order.SellPrice.Quote.Amount), int64(order.SellPrice.Base.Amount))
+			quote = p.market.QuoteAmount(int64(order.ForSale))
+			base = new(big.Rat).Mul(quote, price)
+		}
+
+		key := price.RatString()
+		level, ok := byPrice[key]
+		if !ok {
+			level = &PriceLevel{
+				Price:       price,
+				BaseAmount:  new(big.Rat),
+				QuoteAmount: new(big.Rat),
+			}
+			byPrice[key] = level
+		}
+
+		level.BaseAmount.Add(level.BaseAmount, base)
+		level.QuoteAmount.Add(level.QuoteAmount, quote)
+		level.Orders++
+	}
+
+	ret := make(PriceLevels, 0, len(byPrice))
+	for _, level := range byPrice {
+		ret = append(ret, *level)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		if bids {
+			return ret[i].Price.Cmp(ret[j].Price) > 0
+		}
+		return ret[i].Price.Cmp(ret[j].Price) < 0
+	})
+
+	return ret
+}
@@ -0,0 +1,97 @@
+package market
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func limitOrder(id, base string, baseAmount int64, quote string, quoteAmount, forSale int64) map[string]interface{} {
+	return map[string]interface{}{
+		"id":           id,
+		"seller":       "1.2.1",
+		"expiration":   "2030-01-01T00:00:00",
+		"for_sale":     forSale,
+		"deferred_fee": 0,
+		"sell_price": map[string]interface{}{
+			"base":  map[string]interface{}{"amount": baseAmount, "asset_id": base},
+			"quote": map[string]interface{}{"amount": quoteAmount, "asset_id": quote},
+		},
+	}
+}
+
+func TestOrderBookLevels(t *testing.T) {
+	book := NewOrderBook(nil, testMarket(), nil)
+	book.synced = true
+
+	updates := 0
+	book.OnUpdate(func() {
+		updates++
+	})
+
+	// bids sell CNY for BTS, asks sell BTS for CNY
+	msg := []interface{}{
+		[]interface{}{
+			limitOrder("1.7.1", "1.3.113", 10000, "1.3.0", 1000000, 10000),  // 0.1 CNY/BTS, 1 CNY
+			limitOrder("1.7.2", "1.3.113", 20000, "1.3.0", 1000000, 20000),  // 0.2 CNY/BTS, 2 CNY
+			limitOrder("1.7.3", "1.3.113", 40000, "1.3.0", 2000000, 10000),  // 0.2 CNY/BTS, 1 CNY
+			limitOrder("1.7.4", "1.3.0", 1000000, "1.3.113", 30000, 500000), // 0.3 CNY/BTS, 5 BTS
+			limitOrder("1.7.5", "1.3.0", 1000000, "1.3.113", 25000, 200000), // 0.25 CNY/BTS, 2 BTS
+		},
+	}
+
+	assert.NoError(t, book.HandleNotice(msg))
+	assert.Equal(t, 1, updates)
+
+	bids := book.Bids()
+	if assert.Len(t, bids, 2) {
+		assert.Equal(t, "1/5", bids[0].Price.RatString())
+		assert.Equal(t, "3", bids[0].BaseAmount.RatString())
+		assert.Equal(t, "15", bids[0].QuoteAmount.RatString())
+		assert.Equal(t, 2, bids[0].Orders)
+		assert.Equal(t, "1/10", bids[1].Price.RatString())
+	}
+
+	ask, ok := book.BestAsk()
+	if assert.True(t, ok) {
+		assert.Equal(t, "1/4", ask.Price.RatString())
+		assert.Equal(t, "2", ask.QuoteAmount.RatString())
+		assert.Equal(t, "1/2", ask.BaseAmount.RatString())
+	}
+
+	// a partial fill updates the order, a cancel removes it
+	msg = []interface{}{
+		[]interface{}{
+			limitOrder("1.7.2", "1.3.113", 20000, "1.3.0", 1000000, 5000),
+			"1.7.3",
+		},
+	}
+
+	assert.NoError(t, book.HandleNotice(msg))
+	bid, ok := book.BestBid()
+	if assert.True(t, ok) {
+		assert.Equal(t, "1/2", bid.BaseAmount.RatString())
+		assert.Equal(t, 1, bid.Orders)
+	}
+
+	assert.Len(t, book.Orders(), 4)
+	assert.True(t, book.Synced())
+}
+
+func TestOrderBookGap(t *testing.T) {
+	book := NewOrderBook(nil, testMarket(), nil)
+	book.synced = true
+
+	// the book holds every order, so an unknown removal is a gap
+	assert.NoError(t, book.HandleNotice([]interface{}{"1.7.99"}))
+	assert.False(t, book.Synced())
+	assert.Len(t, book.trigger, 1)
+
+	// notices received while seeding are deferred
+	book.seeding = true
+	assert.NoError(t, book.HandleNotice([]interface{}{
+		limitOrder("1.7.1", "1.3.113", 10000, "1.3.0", 1000000, 10000),
+	}))
+	assert.Len(t, book.pending, 1)
+	assert.Empty(t, book.Orders())
+}
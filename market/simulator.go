@@ -0,0 +1,493 @@
+package market
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/denkhaus/bitshares/operations"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
+)
+
+const (
+	//GraphenePercent100 is 100% in Graphene's fixed point percent representation.
+	GraphenePercent100 = 10000
+	//GrapheneCollateralRatioDenom is the denominator of collateral ratios like MCR and MSSR.
+	GrapheneCollateralRatioDenom = 1000
+)
+
+var (
+	ErrFillOrKill    = errors.New("fill or kill order could not be filled")
+	ErrInvalidOrder  = errors.New("invalid order")
+	ErrUnknownAsset  = errors.New("unknown asset")
+	ErrBlackSwan     = errors.New("margin call can not cover its debt, black swan")
+	ErrOrderNotFound = errors.New("order not found")
+)
+
+//SimulationResult is the outcome of a LimitOrderCreateOperation applied by a Simulator.
+type SimulationResult struct {
+	//OrderID is the ID assigned to the new order.
+	OrderID types.LimitOrderID
+	//Fills are the virtual fill order operations in the order the chain emits them.
+	Fills []operations.FillOrderOperation
+	//Order is the remainder of the new order placed on the book or nil if nothing remains.
+	Order *types.LimitOrder
+}
+
+//Simulator matches limit orders offline following Graphene's matching rules:
+//price-time priority at the maker price, rounding in favor of the bigger order,
+//culling of dust remainders, margin calls at the maximum short squeeze price
+//and market fees charged on the received asset as configured by its issuer.
+//Force settlements, global settlement and target collateral ratios are not simulated.
+type Simulator struct {
+	assets    map[string]types.Asset
+	bitassets map[string]types.BitAssetData
+	orders    map[string]types.LimitOrder
+	calls     map[string]types.CallOrder
+	nextID    uint64
+}
+
+//NewSimulator creates a Simulator. assets must contain every asset of the
+//simulated markets, orders and calls are the book snapshot to start from.
+func NewSimulator(assets types.Assets, orders types.LimitOrders, calls types.CallOrders) *Simulator {
+	sim := &Simulator{
+		assets:    make(map[string]types.Asset, len(assets)),
+		bitassets: make(map[string]types.BitAssetData),
+		orders:    make(map[string]types.LimitOrder, len(orders)),
+		calls:     make(map[string]types.CallOrder, len(calls)),
+		nextID:    1,
+	}
+
+	for _, asset := range assets {
+		sim.assets[asset.ID.ID()] = asset
+	}
+
+	for _, order := range orders {
+		sim.orders[order.ID.ID()] = order
+		if id := uint64(order.ID.Instance()); id >= sim.nextID {
+			sim.nextID = id + 1
+		}
+	}
+
+	for _, call := range calls {
+		sim.calls[call.ID.ID()] = call
+	}
+
+	return sim
+}
+
+//SetBitAssetData sets the current feed and options of the market issued asset.
+//Call orders with this debt asset are only margin called if its BitAssetData is known.
+func (p *Simulator) SetBitAssetData(asset types.GrapheneObject, data types.BitAssetData) {
+	p.bitassets[asset.ID()] = data
+}
+
+//Orders returns the limit orders on the simulated book.
+func (p *Simulator) Orders() types.LimitOrders {
+	ret := make(types.LimitOrders, 0, len(p.orders))
+	for _, order := range p.orders {
+		ret = append(ret, order)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].ID.Instance() < ret[j].ID.Instance()
+	})
+
+	return ret
+}
+
+//CallOrders returns the call orders of the simulation.
+func (p *Simulator) CallOrders() types.CallOrders {
+	ret := make(types.CallOrders, 0, len(p.calls))
+	for _, call := range p.calls {
+		ret = append(ret, call)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].ID.Instance() < ret[j].ID.Instance()
+	})
+
+	return ret
+}
+
+//Cancel removes the limit order id from the book.
+func (p *Simulator) Cancel(id types.GrapheneObject) error {
+	if _, ok := p.orders[id.ID()]; !ok {
+		return ErrOrderNotFound
+	}
+
+	delete(p.orders, id.ID())
+	return nil
+}
+
+func (p *Simulator) clone() *Simulator {
+	sim := &Simulator{
+		assets:    p.assets,
+		bitassets: p.bitassets,
+		orders:    make(map[string]types.LimitOrder, len(p.orders)),
+		calls:     make(map[string]types.CallOrder, len(p.calls)),
+		nextID:    p.nextID,
+	}
+
+	for id, order := range p.orders {
+		sim.orders[id] = order
+	}
+
+	for id, call := range p.calls {
+		sim.calls[id] = call
+	}
+
+	return sim
+}
+
+//Apply places the order described by op on the book and matches it.
+//A fill or kill order that can not be filled completely leaves the book unchanged and returns ErrFillOrKill.
+func (p *Simulator) Apply(op *operations.LimitOrderCreateOperation) (*SimulationResult, error) {
+	if op.AmountToSell.Amount <= 0 || op.MinToReceive.Amount <= 0 ||
+		op.AmountToSell.Asset.Equals(&op.MinToReceive.Asset) {
+		return nil, ErrInvalidOrder
+	}
+
+	for _, asset := range []types.AssetID{op.AmountToSell.Asset, op.MinToReceive.Asset} {
+		if _, ok := p.assets[asset.ID()]; !ok {
+			return nil, errors.Annotate(ErrUnknownAsset, asset.ID())
+		}
+	}
+
+	sim := p.clone()
+	id := types.LimitOrderIDFromObject(
+		types.NewLimitOrderID(fmt.Sprintf("1.7.%d", sim.nextID)),
+	)
+	sim.nextID++
+
+	taker := types.LimitOrder{
+		ID:         id,
+		Seller:     op.Seller,
+		Expiration: op.Expiration,
+		ForSale:    types.UInt64(op.AmountToSell.Amount),
+		SellPrice: types.Price{
+			Base:  op.AmountToSell,
+			Quote: op.MinToReceive,
+		},
+	}
+
+	res := &SimulationResult{
+		OrderID: id,
+	}
+
+	filled, err := sim.match(&taker, res)
+	if err != nil {
+		return nil, errors.Annotate(err, "match")
+	}
+
+	if op.FillOrKill && !filled {
+		return nil, ErrFillOrKill
+	}
+
+	if !filled {
+		sim.orders[id.ID()] = taker
+		res.Order = &taker
+	}
+
+	*p = *sim
+	return res, nil
+}
+
+//makers returns the limit orders selling what taker receives for what it sells,
+//best price first and in order of creation at equal prices.
+func (p *Simulator) makers(taker *types.LimitOrder) []types.LimitOrder {
+	var ret []types.LimitOrder
+	for _, order := range p.orders {
+		if order.SellPrice.Base.Asset.Equals(&taker.SellPrice.Quote.Asset) &&
+			order.SellPrice.Quote.Asset.Equals(&taker.SellPrice.Base.Asset) {
+			ret = append(ret, order)
+		}
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		if c := comparePrice(ret[i].SellPrice, ret[j].SellPrice); c != 0 {
+			return c > 0
+		}
+		return ret[i].ID.Instance() < ret[j].ID.Instance()
+	})
+
+	return ret
+}
+
+//match matches taker against the book and returns true if nothing of taker remains.
+func (p *Simulator) match(taker *types.LimitOrder, res *SimulationResult) (bool, error) {
+	makers := p.makers(taker)
+
+	// an order selling a market issued asset for its backing asset may match margin calls
+	calls, callPrice := p.marginCalls(taker)
+
+	for {
+		var maker *types.LimitOrder
+		if len(makers) > 0 && crosses(makers[0].SellPrice, taker.SellPrice) {
+			maker = &makers[0]
+		}
+
+		// limit orders paying more than the margin calls are matched first
+		if len(calls) > 0 && crosses(callPrice, taker.SellPrice) &&
+			(maker == nil || comparePrice(maker.SellPrice, callPrice) <= 0) {
+			done, err := p.matchCall(taker, &calls[0], callPrice, res)
+			if err != nil {
+				return false, errors.Annotate(err, "matchCall")
+			}
+
+			calls = calls[1:]
+			if done {
+				return true, nil
+			}
+
+			continue
+		}
+
+		if maker == nil {
+			return false, nil
+		}
+
+		done := p.matchLimit(taker, maker, res)
+		makers = makers[1:]
+		if done {
+			return true, nil
+		}
+	}
+}
+
+//matchLimit matches taker and maker at the maker price. The smaller order is filled
+//completely with amounts rounded in favor of the bigger order, a dust remainder of
+//the smaller order is culled. It returns true if nothing of taker remains.
+func (p *Simulator) matchLimit(taker, maker *types.LimitOrder, res *SimulationResult) bool {
+	price := maker.SellPrice
+	takerForSale := int64(taker.ForSale)
+	makerForSale := int64(maker.ForSale)
+
+	var takerPays, takerReceives int64
+	takerSmaller := takerForSale <= multiply(makerForSale, price, true, false)
+	if takerSmaller {
+		takerReceives = multiply(takerForSale, price, false, false)
+		if takerReceives == 0 {
+			// taker would pay something for nothing
+			return true
+		}
+		takerPays = multiply(takerReceives, price, true, true)
+	} else {
+		takerPays = multiply(makerForSale, price, true, false)
+		if takerPays == 0 {
+			// maker would pay something for nothing
+			delete(p.orders, maker.ID.ID())
+			return false
+		}
+		takerReceives = multiply(takerPays, price, false, true)
+	}
+
+	res.Fills = append(res.Fills,
+		p.fillLimit(taker, takerPays, takerReceives, price, false),
+		p.fillLimit(maker, takerReceives, takerPays, price, true),
+	)
+
+	// the remainder of the smaller order is culled
+	if !takerSmaller || maker.ForSale == 0 || isDust(*maker) {
+		delete(p.orders, maker.ID.ID())
+	} else {
+		p.orders[maker.ID.ID()] = *maker
+	}
+
+	return takerSmaller || taker.ForSale == 0 || isDust(*taker)
+}
+
+//fillLimit reduces order by pays and returns the fill operation with the market fee of receives.
+func (p *Simulator) fillLimit(order *types.LimitOrder, pays, receives int64, price types.Price, isMaker bool) operations.FillOrderOperation {
+	order.ForSale -= types.UInt64(pays)
+
+	paysAsset := order.SellPrice.Base.Asset
+	receivesAsset := order.SellPrice.Quote.Asset
+
+	fill := operations.FillOrderOperation{
+		OrderID:   order.ID.ObjectID,
+		AccountID: order.Seller,
+		Pays:      types.AssetAmount{Asset: paysAsset, Amount: types.Int64(pays)},
+		Receives:  types.AssetAmount{Asset: receivesAsset, Amount: types.Int64(receives)},
+		IsMaker:   isMaker,
+		FillPrice: price,
+	}
+
+	fee := p.marketFee(receivesAsset, receives)
+	fill.Fee = &fee
+	return fill
+}
+
+//marketFee returns the market fee the issuer of asset charges on amount.
+func (p *Simulator) marketFee(asset types.AssetID, amount int64) types.AssetAmount {
+	fee := types.AssetAmount{Asset: asset}
+
+	opts := p.assets[asset.ID()].Options
+	if types.AssetPermission(opts.Flags)&types.AssetPermissionChargeMarketFee == 0 ||
+		opts.MarketFeePercent == 0 {
+		return fee
+	}
+
+	value := new(big.Int).Mul(big.NewInt(amount), big.NewInt(int64(opts.MarketFeePercent)))
+	value.Quo(value, big.NewInt(GraphenePercent100))
+
+	fee.Amount = types.Int64(value.Int64())
+	if fee.Amount > opts.MaxMarketFee {
+		fee.Amount = opts.MaxMarketFee
+	}
+
+	return fee
+}
+
+//marginCalls returns the call orders taker may match, least collateralized first,
+//and the maximum short squeeze price at which they are matched.
+func (p *Simulator) marginCalls(taker *types.LimitOrder) ([]types.CallOrder, types.Price) {
+	debt := taker.SellPrice.Base.Asset
+	collateral := taker.SellPrice.Quote.Asset
+
+	data, ok := p.bitassets[debt.ID()]
+	if !ok || data.IsPredictionMarket {
+		return nil, types.Price{}
+	}
+
+	feed := data.CurrentFeed
+	if !feed.SettlementPrice.Valid() || !data.Options.ShortBackingAsset.Equals(&collateral) {
+		return nil, types.Price{}
+	}
+
+	// normalize the feed to debt per collateral
+	settlement := feed.SettlementPrice
+	if !settlement.Base.Asset.Equals(&debt) {
+		settlement = invert(settlement)
+	}
+
+	// the calls sell collateral for debt at feed / MSSR, i.e. MSSR times the collateral per debt
+	callPrice := types.Price{
+		Base: types.AssetAmount{
+			Asset:  collateral,
+			Amount: types.Int64(int64(settlement.Quote.Amount) * int64(feed.MaximumShortSqueezeRatio)),
+		},
+		Quote: types.AssetAmount{
+			Asset:  debt,
+			Amount: types.Int64(int64(settlement.Base.Amount) * GrapheneCollateralRatioDenom),
+		},
+	}
+
+	var calls []types.CallOrder
+	for _, call := range p.calls {
+		if !call.CallPrice.Base.Asset.Equals(&collateral) && !call.CallPrice.Quote.Asset.Equals(&collateral) {
+			continue
+		}
+		if !call.CallPrice.Base.Asset.Equals(&debt) && !call.CallPrice.Quote.Asset.Equals(&debt) {
+			continue
+		}
+
+		// margin called if collateral / debt < settlement * MCR
+		lhs := new(big.Int).Mul(big.NewInt(int64(call.Collateral)), big.NewInt(int64(settlement.Base.Amount)))
+		lhs.Mul(lhs, big.NewInt(GrapheneCollateralRatioDenom))
+		rhs := new(big.Int).Mul(big.NewInt(int64(call.Debt)), big.NewInt(int64(settlement.Quote.Amount)))
+		rhs.Mul(rhs, big.NewInt(int64(feed.MaintenanceCollateralRatio)))
+		if lhs.Cmp(rhs) < 0 {
+			calls = append(calls, call)
+		}
+	}
+
+	sort.Slice(calls, func(i, j int) bool {
+		// least collateralized first, i.e. lowest collateral / debt
+		lhs := new(big.Int).Mul(big.NewInt(int64(calls[i].Collateral)), big.NewInt(int64(calls[j].Debt)))
+		rhs := new(big.Int).Mul(big.NewInt(int64(calls[j].Collateral)), big.NewInt(int64(calls[i].Debt)))
+		if c := lhs.Cmp(rhs); c != 0 {
+			return c < 0
+		}
+		return calls[i].ID.Instance() < calls[j].ID.Instance()
+	})
+
+	return calls, callPrice
+}
+
+//matchCall matches taker selling debt against a margin call at price, which is in collateral per debt.
+//It returns true if nothing of taker remains.
+func (p *Simulator) matchCall(taker *types.LimitOrder, call *types.CallOrder, price types.Price, res *SimulationResult) (bool, error) {
+	takerForSale := int64(taker.ForSale)
+
+	callReceives := int64(call.Debt)
+	if takerForSale < callReceives {
+		callReceives = takerForSale
+	}
+
+	// round up in favor of the limit order
+	callPays := multiply(callReceives, price, false, true)
+	if callPays > int64(call.Collateral) {
+		return false, ErrBlackSwan
+	}
+
+	// call orders pay no market fee
+	callFill := operations.FillOrderOperation{
+		OrderID:   call.ID.ObjectID,
+		AccountID: call.Borrower,
+		Pays:      types.AssetAmount{Asset: price.Base.Asset, Amount: types.Int64(callPays)},
+		Receives:  types.AssetAmount{Asset: price.Quote.Asset, Amount: types.Int64(callReceives)},
+		IsMaker:   true,
+		FillPrice: price,
+	}
+	callFill.Fee = &types.AssetAmount{Asset: price.Quote.Asset}
+
+	res.Fills = append(res.Fills,
+		p.fillLimit(taker, callReceives, callPays, price, false),
+		callFill,
+	)
+
+	call.Debt -= types.Int64(callReceives)
+	call.Collateral -= types.Int64(callPays)
+	if call.Debt == 0 {
+		// the remaining collateral is returned to the borrower
+		delete(p.calls, call.ID.ID())
+	} else {
+		p.calls[call.ID.ID()] = *call
+	}
+
+	return taker.ForSale == 0 || isDust(*taker), nil
+}
+
+//multiply converts amount at price into the other asset of price.
+//If fromBase is true, amount is in the base asset of price, otherwise in its quote asset.
+func multiply(amount int64, price types.Price, fromBase, roundUp bool) int64 {
+	num, den := int64(price.Quote.Amount), int64(price.Base.Amount)
+	if !fromBase {
+		num, den = den, num
+	}
+
+	value := new(big.Int).Mul(big.NewInt(amount), big.NewInt(num))
+	mod := new(big.Int)
+	value.QuoRem(value, big.NewInt(den), mod)
+	if roundUp && mod.Sign() != 0 {
+		value.Add(value, big.NewInt(1))
+	}
+
+	return value.Int64()
+}
+
+//comparePrice compares two prices of the same asset pair.
+func comparePrice(a, b types.Price) int {
+	lhs := new(big.Int).Mul(big.NewInt(int64(a.Base.Amount)), big.NewInt(int64(b.Quote.Amount)))
+	rhs := new(big.Int).Mul(big.NewInt(int64(b.Base.Amount)), big.NewInt(int64(a.Quote.Amount)))
+	return lhs.Cmp(rhs)
+}
+
+//crosses returns true if maker, selling what taker receives, satisfies the price of taker.
+func crosses(maker, taker types.Price) bool {
+	return comparePrice(maker, invert(taker)) >= 0
+}
+
+func invert(price types.Price) types.Price {
+	return types.Price{
+		Base:  price.Quote,
+		Quote: price.Base,
+	}
+}
+
+//isDust returns true if the order would receive nothing for its remaining amount at its own price.
+func isDust(order types.LimitOrder) bool {
+	return multiply(int64(order.ForSale), order.SellPrice, true, false) == 0
+}
@@ -0,0 +1,188 @@
+package market
+
+import (
+	"testing"
+
+	"github.com/denkhaus/bitshares/operations"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func simAsset(id string, precision int, feePercent, maxFee int64) types.Asset {
+	asset := types.Asset{
+		ID:        types.AssetIDFromObject(types.NewAssetID(id)),
+		Precision: precision,
+	}
+
+	if feePercent > 0 {
+		asset.Options.Flags = types.UInt16(types.AssetPermissionChargeMarketFee)
+		asset.Options.MarketFeePercent = types.UInt16(feePercent)
+		asset.Options.MaxMarketFee = types.Int64(maxFee)
+	}
+
+	return asset
+}
+
+func simOrder(id string, sell string, sellAmount int64, receive string, receiveAmount, forSale int64) types.LimitOrder {
+	return types.LimitOrder{
+		ID:      types.LimitOrderIDFromObject(types.NewLimitOrderID(id)),
+		Seller:  types.AccountIDFromObject(types.NewAccountID("1.2.100")),
+		ForSale: types.UInt64(forSale),
+		SellPrice: types.Price{
+			Base:  amount(sell, sellAmount),
+			Quote: amount(receive, receiveAmount),
+		},
+	}
+}
+
+func simCreate(sell string, sellAmount int64, receive string, receiveAmount int64, fillOrKill bool) *operations.LimitOrderCreateOperation {
+	return &operations.LimitOrderCreateOperation{
+		Seller:       types.AccountIDFromObject(types.NewAccountID("1.2.200")),
+		AmountToSell: amount(sell, sellAmount),
+		MinToReceive: amount(receive, receiveAmount),
+		FillOrKill:   fillOrKill,
+	}
+}
+
+func TestSimulatorLimitOrders(t *testing.T) {
+	assets := types.Assets{
+		simAsset("1.3.0", 5, 0, 0),
+		simAsset("1.3.113", 4, 10, 20),
+	}
+
+	orders := types.LimitOrders{
+		simOrder("1.7.1", "1.3.0", 1000000, "1.3.113", 30000, 1000000),
+		simOrder("1.7.3", "1.3.0", 1000000, "1.3.113", 25000, 500000),
+		simOrder("1.7.2", "1.3.0", 1000000, "1.3.113", 25000, 1000000),
+	}
+
+	sim := NewSimulator(assets, orders, nil)
+
+	// fill or kill exceeding the book leaves it untouched
+	_, err := sim.Apply(simCreate("1.3.113", 100000, "1.3.0", 1200000, true))
+	assert.Equal(t, ErrFillOrKill, err)
+	assert.Len(t, sim.Orders(), 3)
+
+	res, err := sim.Apply(simCreate("1.3.113", 40000, "1.3.0", 1200000, false))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "1.7.4", res.OrderID.ID())
+	assert.Nil(t, res.Order)
+	if !assert.Len(t, res.Fills, 6) {
+		return
+	}
+
+	// price-time priority: 1.7.2 before 1.7.3 at the same price, then 1.7.1
+	expected := []struct {
+		order    string
+		pays     int64
+		receives int64
+		fee      int64
+		isMaker  bool
+	}{
+		{"1.7.4", 25000, 1000000, 0, false},
+		{"1.7.2", 1000000, 25000, 20, true},
+		{"1.7.4", 12500, 500000, 0, false},
+		{"1.7.3", 500000, 12500, 12, true},
+		{"1.7.4", 2500, 83333, 0, false},
+		{"1.7.1", 83333, 2500, 2, true},
+	}
+
+	for idx, exp := range expected {
+		fill := res.Fills[idx]
+		assert.Equal(t, exp.order, fill.OrderID.ID())
+		assert.Equal(t, types.Int64(exp.pays), fill.Pays.Amount)
+		assert.Equal(t, types.Int64(exp.receives), fill.Receives.Amount)
+		assert.Equal(t, types.Int64(exp.fee), fill.Fee.Amount)
+		assert.Equal(t, exp.isMaker, fill.IsMaker)
+	}
+
+	book := sim.Orders()
+	if assert.Len(t, book, 1) {
+		assert.Equal(t, "1.7.1", book[0].ID.ID())
+		assert.Equal(t, types.UInt64(916667), book[0].ForSale)
+	}
+
+	// an order not crossing the book is placed
+	res, err = sim.Apply(simCreate("1.3.113", 10000, "1.3.0", 1000000, false))
+	if assert.NoError(t, err) {
+		assert.Empty(t, res.Fills)
+		if assert.NotNil(t, res.Order) {
+			assert.Equal(t, "1.7.5", res.Order.ID.ID())
+		}
+	}
+
+	assert.Len(t, sim.Orders(), 2)
+	assert.NoError(t, sim.Cancel(types.NewLimitOrderID("1.7.5")))
+	assert.Equal(t, ErrOrderNotFound, sim.Cancel(types.NewLimitOrderID("1.7.5")))
+}
+
+func TestSimulatorMarginCalls(t *testing.T) {
+	assets := types.Assets{
+		simAsset("1.3.0", 5, 0, 0),
+		simAsset("1.3.121", 4, 0, 0),
+	}
+
+	orders := types.LimitOrders{
+		// 250 BTS per USD, better than the margin calls
+		simOrder("1.7.10", "1.3.0", 2500000, "1.3.121", 10000, 1000000),
+	}
+
+	callOrder := func(id string, collateral, debt int64) types.CallOrder {
+		return types.CallOrder{
+			ID:         types.CallOrderIDFromObject(types.NewCallOrderID(id)),
+			Borrower:   types.AccountIDFromObject(types.NewAccountID("1.2.300")),
+			Collateral: types.Int64(collateral),
+			Debt:       types.Int64(debt),
+			CallPrice: types.Price{
+				Base:  amount("1.3.0", collateral),
+				Quote: amount("1.3.121", debt),
+			},
+		}
+	}
+
+	calls := types.CallOrders{
+		callOrder("1.8.1", 3000000, 10000), // 300 BTS per USD, below MCR
+		callOrder("1.8.2", 5000000, 10000), // 500 BTS per USD
+	}
+
+	sim := NewSimulator(assets, orders, calls)
+
+	var data types.BitAssetData
+	data.Options.ShortBackingAsset = types.AssetIDFromObject(types.NewAssetID("1.3.0"))
+	data.CurrentFeed.MaintenanceCollateralRatio = 1750
+	data.CurrentFeed.MaximumShortSqueezeRatio = 1100
+	data.CurrentFeed.SettlementPrice = types.Price{
+		Base:  amount("1.3.121", 10000),
+		Quote: amount("1.3.0", 2000000),
+	}
+	sim.SetBitAssetData(types.NewAssetID("1.3.121"), data)
+
+	res, err := sim.Apply(simCreate("1.3.121", 20000, "1.3.0", 3000000, false))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	if !assert.Len(t, res.Fills, 4) {
+		return
+	}
+
+	// the better limit order first, then the margin call at 220 BTS per USD
+	assert.Equal(t, "1.7.10", res.Fills[1].OrderID.ID())
+	assert.Equal(t, types.Int64(4000), res.Fills[1].Receives.Amount)
+
+	assert.Equal(t, "1.8.1", res.Fills[3].OrderID.ID())
+	assert.Equal(t, types.Int64(2200000), res.Fills[3].Pays.Amount)
+	assert.Equal(t, types.Int64(10000), res.Fills[3].Receives.Amount)
+
+	if assert.NotNil(t, res.Order) {
+		assert.Equal(t, types.UInt64(6000), res.Order.ForSale)
+	}
+
+	remaining := sim.CallOrders()
+	if assert.Len(t, remaining, 1) {
+		assert.Equal(t, "1.8.2", remaining[0].ID.ID())
+	}
+}
@@ -28,8 +28,8 @@ func (p *websocketAPI) NewMemoBuilder(from, to types.GrapheneObject, memo string
 	return &builder
 }
 
-// Encrypt encrypts the memo message with the corresponding private key found in keyBag
-func (p *MemoBuilder) Encrypt(keyBag *crypto.KeyBag) (*types.Memo, error) {
+// Encrypt encrypts the memo message with the corresponding key available in signer
+func (p *MemoBuilder) Encrypt(signer crypto.Signer) (*types.Memo, error) {
 	accts, err := p.api.GetAccounts(p.from, p.to)
 	if err != nil {
 		return nil, errors.Annotate(err, "GetAccounts")
@@ -51,7 +51,7 @@ func (p *MemoBuilder) Encrypt(keyBag *crypto.KeyBag) (*types.Memo, error) {
 		Nonce: types.UInt64(rand.Uint64()),
 	}
 
-	if err := keyBag.EncryptMemo(&memo, p.memo); err != nil {
+	if err := crypto.EncryptMemo(signer, &memo, p.memo); err != nil {
 		return nil, errors.Annotate(err, "EncryptMemo")
 	}
 
@@ -0,0 +1,8 @@
+package operations
+
+import (
+	"github.com/denkhaus/bitshares/types"
+)
+
+//ChainParameters moved to types, where GlobalProperties shares it.
+type ChainParameters = types.ChainParameters
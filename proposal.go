@@ -0,0 +1,85 @@
+package bitshares
+
+import (
+	"github.com/denkhaus/bitshares/crypto"
+	"github.com/denkhaus/bitshares/operations"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
+)
+
+var (
+	ErrNoApprovals = errors.New("no approvals given")
+)
+
+//ProposalApprovals selects the approvals of a proposal to add or remove.
+//Active and Owner approve with the respective authority of an account, Keys with a single key.
+type ProposalApprovals struct {
+	Active types.AccountIDs
+	Owner  types.AccountIDs
+	Keys   types.PublicKeys
+}
+
+func (p ProposalApprovals) empty() bool {
+	return len(p.Active)+len(p.Owner)+len(p.Keys) == 0
+}
+
+//normalized replaces nil lists, which would encode as JSON null.
+func (p ProposalApprovals) normalized() ProposalApprovals {
+	if p.Active == nil {
+		p.Active = types.AccountIDs{}
+	}
+	if p.Owner == nil {
+		p.Owner = types.AccountIDs{}
+	}
+	if p.Keys == nil {
+		p.Keys = types.PublicKeys{}
+	}
+
+	return p
+}
+
+//ApproveProposal adds approvals to proposal, paid by feePayingAccount.
+func (p *websocketAPI) ApproveProposal(signer crypto.Signer, feePayingAccount, proposal, feeAsset types.GrapheneObject, approvals ProposalApprovals) error {
+	return p.updateProposal(signer, feePayingAccount, proposal, feeAsset, approvals, ProposalApprovals{})
+}
+
+//RevokeProposalApproval removes approvals from proposal, paid by feePayingAccount.
+func (p *websocketAPI) RevokeProposalApproval(signer crypto.Signer, feePayingAccount, proposal, feeAsset types.GrapheneObject, approvals ProposalApprovals) error {
+	return p.updateProposal(signer, feePayingAccount, proposal, feeAsset, ProposalApprovals{}, approvals)
+}
+
+func (p *websocketAPI) updateProposal(signer crypto.Signer, feePayingAccount, proposal, feeAsset types.GrapheneObject,
+	add, remove ProposalApprovals) error {
+	if add.empty() && remove.empty() {
+		return ErrNoApprovals
+	}
+
+	add, remove = add.normalized(), remove.normalized()
+	op := operations.ProposalUpdateOperation{
+		ActiveApprovalsToAdd:    add.Active,
+		ActiveApprovalsToRemove: remove.Active,
+		Extensions:              types.Extensions{},
+		FeePayingAccount:        types.AccountIDFromObject(feePayingAccount),
+		KeyApprovalsToAdd:       add.Keys,
+		KeyApprovalsToRemove:    remove.Keys,
+		OwnerApprovalsToAdd:     add.Owner,
+		OwnerApprovalsToRemove:  remove.Owner,
+		Proposal:                types.ProposalIDFromObject(proposal),
+	}
+
+	trx, err := p.BuildSignedTransaction(signer, feeAsset, &op)
+	if err != nil {
+		return errors.Annotate(err, "BuildSignedTransaction")
+	}
+
+	if err := p.BroadcastTransaction(trx); err != nil {
+		return errors.Annotate(err, "BroadcastTransaction")
+	}
+
+	return nil
+}
+
+//CheckProposal evaluates whether the approvals collected by proposal suffice to execute it.
+func (p *websocketAPI) CheckProposal(proposal *types.Proposal) (*crypto.AuthorityReport, error) {
+	return p.authorityChecker().CheckProposal(proposal)
+}
@@ -0,0 +1,85 @@
+package bitshares
+
+import (
+	"github.com/denkhaus/bitshares/crypto"
+	"github.com/denkhaus/bitshares/operations"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
+)
+
+const (
+	//FullReferrerPercent is GRAPHENE_100_PERCENT, the upper bound of referrerPercent.
+	FullReferrerPercent = 10000
+)
+
+var (
+	ErrInvalidReferrerPercent = errors.New("referrer percent exceeds 100%")
+)
+
+//RegisterAccount registers the account name paid by registrar, which has to be a lifetime member.
+//referrer receives referrerPercent, in units of 0.01%, of the registrar's share of the new account's fees.
+//The node estimates the fee, which is higher for premium names, see types.IsPremiumAccountName.
+func (p *websocketAPI) RegisterAccount(signer crypto.Signer, registrar, referrer, feeAsset types.GrapheneObject, name string,
+	ownerKey, activeKey, memoKey types.PublicKey, referrerPercent uint16) error {
+	op, err := newAccountCreateOperation(registrar, referrer, name, ownerKey, activeKey, memoKey, referrerPercent)
+	if err != nil {
+		return err
+	}
+
+	trx, err := p.BuildSignedTransaction(signer, feeAsset, op)
+	if err != nil {
+		return errors.Annotate(err, "BuildSignedTransaction")
+	}
+
+	if err := p.BroadcastTransaction(trx); err != nil {
+		return errors.Annotate(err, "BroadcastTransaction")
+	}
+
+	return nil
+}
+
+//RegisterAccountWithPassword registers the account name like RegisterAccount
+//with owner, active and memo keys derived from password, which are returned.
+func (p *websocketAPI) RegisterAccountWithPassword(signer crypto.Signer, registrar, referrer, feeAsset types.GrapheneObject, name string,
+	password string, referrerPercent uint16) (*crypto.PasswordKeys, error) {
+	keys, err := crypto.DerivePasswordKeys(name, password)
+	if err != nil {
+		return nil, errors.Annotate(err, "DerivePasswordKeys")
+	}
+
+	if err := p.RegisterAccount(signer, registrar, referrer, feeAsset, name, *keys.Owner.PublicKey(),
+		*keys.Active.PublicKey(), *keys.Memo.PublicKey(), referrerPercent); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func newAccountCreateOperation(registrar, referrer types.GrapheneObject, name string,
+	ownerKey, activeKey, memoKey types.PublicKey, referrerPercent uint16) (*operations.AccountCreateOperation, error) {
+	if err := types.ValidateAccountName(name); err != nil {
+		return nil, err
+	}
+
+	if referrerPercent > FullReferrerPercent {
+		return nil, ErrInvalidReferrerPercent
+	}
+
+	op := operations.AccountCreateOperation{
+		Registrar:       types.AccountIDFromObject(registrar),
+		Referrer:        types.AccountIDFromObject(referrer),
+		ReferrerPercent: types.UInt16(referrerPercent),
+		Owner:           types.NewKeyAuthority(ownerKey),
+		Active:          types.NewKeyAuthority(activeKey),
+		Name:            types.NewString(name),
+		Extensions:      types.AccountCreateExtensions{},
+		Options: types.AccountOptions{
+			MemoKey:       memoKey,
+			VotingAccount: types.AccountIDFromObject(types.NewAccountID(types.ProxyToSelfAccount)),
+			Votes:         types.Votes{},
+			Extensions:    types.Extensions{},
+		},
+	}
+
+	return &op, nil
+}
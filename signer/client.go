@@ -0,0 +1,137 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
+	"github.com/pquerna/ffjson/ffjson"
+)
+
+const (
+	ClientTimeout = 30 * time.Second
+)
+
+//Client talks to a signing Server.
+type Client struct {
+	baseURL string
+	client  *http.Client
+	token   string
+}
+
+//NewClient creates a Client for a Server listening on network "unix" or "tcp" at address.
+func NewClient(network, address string) *Client {
+	if network != "unix" {
+		return &Client{
+			baseURL: "http://" + address,
+			client:  &http.Client{Timeout: ClientTimeout},
+		}
+	}
+
+	dialer := net.Dialer{}
+	transport := http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return dialer.DialContext(ctx, "unix", address)
+		},
+	}
+
+	return &Client{
+		baseURL: "http://unix",
+		client: &http.Client{
+			Timeout:   ClientTimeout,
+			Transport: &transport,
+		},
+	}
+}
+
+//SetToken authenticates requests with token.
+func (p *Client) SetToken(token string) {
+	p.token = token
+}
+
+func (p *Client) do(method, path string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequest(method, p.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Annotate(err, "NewRequest")
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if p.token != "" {
+		req.Header.Set("Authorization", "Bearer "+p.token)
+	}
+
+	return p.client.Do(req)
+}
+
+//Publics returns the public keys the server can sign with.
+func (p *Client) Publics() (types.PublicKeys, error) {
+	resp, err := p.do(http.MethodGet, PathKeys, nil)
+	if err != nil {
+		return nil, errors.Annotate(err, "Get")
+	}
+
+	var ret types.PublicKeys
+	if err := p.decode(resp, &ret); err != nil {
+		return nil, errors.Annotate(err, "decode")
+	}
+
+	return ret, nil
+}
+
+//SignTransaction asks the server to sign tx with keys and returns the signed transaction.
+func (p *Client) SignTransaction(tx *types.SignedTransaction, keys types.PublicKeys) (*types.SignedTransaction, error) {
+	data, err := ffjson.Marshal(SignRequest{
+		Transaction: tx,
+		SigningKeys: keys,
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "Marshal [SignRequest]")
+	}
+
+	resp, err := p.do(http.MethodPost, PathSign, data)
+	if err != nil {
+		return nil, errors.Annotate(err, "Post")
+	}
+
+	ret := SignResponse{}
+	if err := p.decode(resp, &ret); err != nil {
+		return nil, errors.Annotate(err, "decode")
+	}
+
+	return ret.Transaction, nil
+}
+
+func (p *Client) decode(resp *http.Response, v interface{}) error {
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Annotate(err, "ReadAll")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		ret := SignResponse{}
+		if err := ffjson.Unmarshal(data, &ret); err != nil || ret.Error == "" {
+			return errors.Errorf("signer responded %s", resp.Status)
+		}
+
+		if resp.StatusCode == http.StatusForbidden {
+			return &PolicyError{Reason: ret.Error}
+		}
+
+		return errors.New(ret.Error)
+	}
+
+	if err := ffjson.Unmarshal(data, v); err != nil {
+		return errors.Annotate(err, "Unmarshal")
+	}
+
+	return nil
+}
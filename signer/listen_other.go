@@ -0,0 +1,26 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd
+
+package signer
+
+import (
+	"net"
+	"os"
+
+	"github.com/juju/errors"
+)
+
+//listenUnix falls back to restricting the socket after its creation on platforms without umask.
+func listenUnix(address string) (net.Listener, error) {
+	l, err := net.Listen("unix", address)
+	if err != nil {
+		return nil, errors.Annotate(err, "Listen")
+	}
+
+	if err := os.Chmod(address, 0600); err != nil {
+		l.Close()
+		return nil, errors.Annotate(err, "Chmod [socket]")
+	}
+
+	return l, nil
+}
@@ -0,0 +1,17 @@
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd
+// +build darwin dragonfly freebsd linux netbsd openbsd
+
+package signer
+
+import (
+	"net"
+	"syscall"
+)
+
+//listenUnix creates the socket with a restrictive umask, so it is never accessible by others.
+func listenUnix(address string) (net.Listener, error) {
+	mask := syscall.Umask(0177)
+	defer syscall.Umask(mask)
+
+	return net.Listen("unix", address)
+}
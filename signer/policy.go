@@ -0,0 +1,254 @@
+package signer
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/denkhaus/bitshares/operations"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
+	"github.com/pquerna/ffjson/ffjson"
+)
+
+var (
+	ErrPolicyViolation = errors.New("policy violation")
+)
+
+//PolicyError describes why a transaction was rejected by a Policy.
+type PolicyError struct {
+	Reason string
+}
+
+func (p PolicyError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrPolicyViolation, p.Reason)
+}
+
+//IsPolicyError returns true if err was caused by a rejecting Policy.
+func IsPolicyError(err error) bool {
+	_, ok := errors.Cause(err).(*PolicyError)
+	return ok
+}
+
+func policyErrorf(format string, args ...interface{}) error {
+	return &PolicyError{Reason: fmt.Sprintf(format, args...)}
+}
+
+//Policy restricts the transactions a Service signs.
+//Only operation types listed in AllowedOperations are signed at all.
+//Fees are only accepted in assets listed in MaxFees, up to the cap per operation,
+//and count toward the daily transfer limits.
+//Empty AllowedRecipients, DailyTransferLimits and MaxOrderSize don't restrict anything,
+//assets without a limit are unrestricted. Operations proposed by a proposal are not inspected.
+type Policy struct {
+	AllowedOperations   []types.OperationType  `json:"allowed_operations"`
+	AllowedRecipients   []string               `json:"allowed_recipients"`
+	DailyTransferLimits map[string]types.Int64 `json:"daily_transfer_limits"`
+	MaxOrderSize        map[string]types.Int64 `json:"max_order_size"`
+	MaxFees             map[string]types.Int64 `json:"max_fees"`
+
+	mu        sync.Mutex
+	day       string
+	spent     map[string]types.Int64
+	statePath string
+}
+
+//policyState is the persisted daily spend of a Policy.
+type policyState struct {
+	Day   string                 `json:"day"`
+	Spent map[string]types.Int64 `json:"spent"`
+}
+
+//Persist loads the daily spend from path if it exists and saves it there
+//whenever a transaction is signed, so restarts don't reset the daily limits.
+func (p *Policy) Persist(path string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Annotate(err, "ReadFile")
+	}
+
+	if err == nil {
+		state := policyState{}
+		if err := ffjson.Unmarshal(data, &state); err != nil {
+			return errors.Annotate(err, "Unmarshal [state]")
+		}
+
+		p.day, p.spent = state.Day, state.Spent
+	}
+
+	p.statePath = path
+	return nil
+}
+
+//save writes the daily spend atomically to the state file.
+func (p *Policy) save() error {
+	data, err := ffjson.Marshal(policyState{Day: p.day, Spent: p.spent})
+	if err != nil {
+		return errors.Annotate(err, "Marshal [state]")
+	}
+
+	tmp := p.statePath + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return errors.Annotate(err, "WriteFile")
+	}
+
+	if err := os.Rename(tmp, p.statePath); err != nil {
+		return errors.Annotate(err, "Rename")
+	}
+
+	return nil
+}
+
+//Spent returns the amounts per asset transferred or paid as fees during the current UTC day.
+func (p *Policy) Spent(now time.Time) map[string]types.Int64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.rollover(now)
+	ret := make(map[string]types.Int64, len(p.spent))
+	for asset, amount := range p.spent {
+		ret[asset] = amount
+	}
+
+	return ret
+}
+
+//Check verifies tx against the policy without accounting its transfers and fees.
+func (p *Policy) Check(tx *types.SignedTransaction, now time.Time) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	_, err := p.check(tx, now)
+	return err
+}
+
+//Apply verifies tx and accounts its transfers and fees if sign succeeds.
+//The policy is locked while sign runs, so concurrent requests can't exceed the limits.
+//If the spend can't be persisted, an error is returned and tx must not be released.
+func (p *Policy) Apply(tx *types.SignedTransaction, now time.Time, sign func() error) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	spend, err := p.check(tx, now)
+	if err != nil {
+		return err
+	}
+
+	if err := sign(); err != nil {
+		return err
+	}
+
+	for asset, amount := range spend {
+		p.spent[asset] += amount
+	}
+
+	if p.statePath != "" {
+		if err := p.save(); err != nil {
+			return errors.Annotate(err, "save")
+		}
+	}
+
+	return nil
+}
+
+func (p *Policy) rollover(now time.Time) {
+	day := now.UTC().Format("2006-01-02")
+	if p.day != day || p.spent == nil {
+		p.day = day
+		p.spent = make(map[string]types.Int64)
+	}
+}
+
+func (p *Policy) check(tx *types.SignedTransaction, now time.Time) (map[string]types.Int64, error) {
+	p.rollover(now)
+
+	if len(tx.Operations) == 0 {
+		return nil, policyErrorf("transaction has no operations")
+	}
+
+	spend := make(map[string]types.Int64)
+	for idx, op := range tx.Operations {
+		if op == nil {
+			return nil, policyErrorf("operation %d is not supported", idx)
+		}
+
+		if !p.operationAllowed(op.Type()) {
+			return nil, policyErrorf("operation %d: %s not allowed", idx, op.Type())
+		}
+
+		//graphene charges the declared fee, however high it is
+		if fee := op.GetFee(); fee.Amount != 0 {
+			asset := fee.Asset.ID()
+			max, ok := p.MaxFees[asset]
+			switch {
+			case fee.Amount < 0:
+				return nil, policyErrorf("operation %d: negative fee", idx)
+			case !ok:
+				return nil, policyErrorf("operation %d: fees in %s not allowed", idx, asset)
+			case fee.Amount > max:
+				return nil, policyErrorf("operation %d: fee %d of %s exceeds %d", idx, fee.Amount, asset, max)
+			}
+
+			spend[asset] += fee.Amount
+		}
+
+		switch op := op.(type) {
+		case *operations.TransferOperation:
+			if !p.recipientAllowed(op.To.ID()) {
+				return nil, policyErrorf("operation %d: recipient %s not allowed", idx, op.To.ID())
+			}
+
+			if op.Amount.Amount < 0 {
+				return nil, policyErrorf("operation %d: negative amount", idx)
+			}
+
+			spend[op.Amount.Asset.ID()] += op.Amount.Amount
+
+		case *operations.LimitOrderCreateOperation:
+			asset := op.AmountToSell.Asset.ID()
+			if max, ok := p.MaxOrderSize[asset]; ok && op.AmountToSell.Amount > max {
+				return nil, policyErrorf("operation %d: order size %d of %s exceeds %d",
+					idx, op.AmountToSell.Amount, asset, max)
+			}
+		}
+	}
+
+	for asset, amount := range spend {
+		limit, ok := p.DailyTransferLimits[asset]
+		if ok && p.spent[asset]+amount > limit {
+			return nil, policyErrorf("daily transfer limit %d of %s exceeded, %d spent today",
+				limit, asset, p.spent[asset])
+		}
+	}
+
+	return spend, nil
+}
+
+func (p *Policy) operationAllowed(typ types.OperationType) bool {
+	for _, allowed := range p.AllowedOperations {
+		if allowed == typ {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *Policy) recipientAllowed(account string) bool {
+	if len(p.AllowedRecipients) == 0 {
+		return true
+	}
+
+	for _, allowed := range p.AllowedRecipients {
+		if allowed == account {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,230 @@
+package signer
+
+import (
+	"crypto/subtle"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
+	"github.com/pquerna/ffjson/ffjson"
+)
+
+const (
+	//MaxRequestSize limits the size of a sign request body.
+	MaxRequestSize = 1 << 20
+
+	PathKeys = "/keys"
+	PathSign = "/sign"
+)
+
+var (
+	ErrInsecureListener = errors.New("tcp listeners require a token")
+)
+
+//Server exposes a Service over HTTP on a unix socket or TCP listener.
+//If a token is set, requests have to carry it as bearer token. Requests from
+//browsers and, on TCP, requests for other hosts than the listener's are refused.
+type Server struct {
+	service  *Service
+	server   *http.Server
+	listener net.Listener
+	token    string
+	address  string
+	port     string
+}
+
+//NewServer creates a Server for service.
+func NewServer(service *Service) *Server {
+	srv := Server{
+		service: service,
+	}
+
+	srv.server = &http.Server{Handler: srv.Handler()}
+	return &srv
+}
+
+//SetToken requires requests to authenticate with token. Call it before Listen.
+func (p *Server) SetToken(token string) {
+	p.token = token
+}
+
+//Handler returns the HTTP handler serving GET /keys and POST /sign.
+func (p *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(PathKeys, p.handleKeys)
+	mux.HandleFunc(PathSign, p.handleSign)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !p.allowedOrigin(r) {
+			writeJSON(w, http.StatusForbidden, SignResponse{Error: "forbidden"})
+			return
+		}
+
+		if !p.authorized(r) {
+			writeJSON(w, http.StatusUnauthorized, SignResponse{Error: "unauthorized"})
+			return
+		}
+
+		mux.ServeHTTP(w, r)
+	})
+}
+
+//allowedOrigin refuses requests of browsers, which send an Origin header, and requests
+//for host names other than the listener address, so DNS rebinding can't reach a TCP listener.
+func (p *Server) allowedOrigin(r *http.Request) bool {
+	if r.Header.Get("Origin") != "" {
+		return false
+	}
+
+	if p.port == "" || r.Host == p.address {
+		return true
+	}
+
+	host, port, err := net.SplitHostPort(r.Host)
+	return err == nil && port == p.port && net.ParseIP(host) != nil
+}
+
+func (p *Server) authorized(r *http.Request) bool {
+	if p.token == "" {
+		return true
+	}
+
+	expected := []byte("Bearer " + p.token)
+	return subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), expected) == 1
+}
+
+//Listen opens a listener on network "unix" or one of the "tcp" networks.
+//A unix socket is only accessible by the owner, a stale socket file is replaced.
+//TCP listeners are refused unless a token is set, any local user could sign otherwise.
+func (p *Server) Listen(network, address string) error {
+	if network == "unix" {
+		if err := os.Remove(address); err != nil && !os.IsNotExist(err) {
+			return errors.Annotate(err, "Remove [socket]")
+		}
+
+		l, err := listenUnix(address)
+		if err != nil {
+			return errors.Annotate(err, "listenUnix")
+		}
+
+		p.listener = l
+		return nil
+	}
+
+	if strings.HasPrefix(network, "tcp") && p.token == "" {
+		return ErrInsecureListener
+	}
+
+	l, err := net.Listen(network, address)
+	if err != nil {
+		return errors.Annotate(err, "Listen")
+	}
+
+	_, port, err := net.SplitHostPort(l.Addr().String())
+	if err != nil {
+		l.Close()
+		return errors.Annotate(err, "SplitHostPort")
+	}
+
+	p.address, p.port = address, port
+	p.listener = l
+	return nil
+}
+
+//Addr returns the address of the listener.
+func (p *Server) Addr() net.Addr {
+	if p.listener == nil {
+		return nil
+	}
+
+	return p.listener.Addr()
+}
+
+//Serve serves requests until Close is called.
+func (p *Server) Serve() error {
+	if p.listener == nil {
+		return errors.New("not listening")
+	}
+
+	if err := p.server.Serve(p.listener); err != nil && err != http.ErrServerClosed {
+		return errors.Annotate(err, "Serve")
+	}
+
+	return nil
+}
+
+//ListenAndServe listens on network and address and serves requests.
+func (p *Server) ListenAndServe(network, address string) error {
+	if err := p.Listen(network, address); err != nil {
+		return errors.Annotate(err, "Listen")
+	}
+
+	return p.Serve()
+}
+
+//Close stops the server.
+func (p *Server) Close() error {
+	return p.server.Close()
+}
+
+func (p *Server) handleKeys(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeJSON(w, http.StatusMethodNotAllowed, SignResponse{Error: "method not allowed"})
+		return
+	}
+
+	pubs := p.service.Publics()
+	if pubs == nil {
+		pubs = types.PublicKeys{}
+	}
+
+	writeJSON(w, http.StatusOK, pubs)
+}
+
+func (p *Server) handleSign(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeJSON(w, http.StatusMethodNotAllowed, SignResponse{Error: "method not allowed"})
+		return
+	}
+
+	data, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, MaxRequestSize))
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, SignResponse{Error: err.Error()})
+		return
+	}
+
+	req := SignRequest{}
+	if err := ffjson.Unmarshal(data, &req); err != nil {
+		writeJSON(w, http.StatusBadRequest, SignResponse{Error: err.Error()})
+		return
+	}
+
+	tx, err := p.service.Sign(&req, r.RemoteAddr)
+	if err != nil {
+		status := http.StatusBadRequest
+		if IsPolicyError(err) {
+			status = http.StatusForbidden
+		}
+
+		writeJSON(w, status, SignResponse{Error: err.Error()})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, SignResponse{Transaction: tx})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	data, err := ffjson.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(data)
+}
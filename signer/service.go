@@ -0,0 +1,162 @@
+package signer
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/denkhaus/bitshares/config"
+	"github.com/denkhaus/bitshares/crypto"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
+	"github.com/pquerna/ffjson/ffjson"
+)
+
+var (
+	ErrNoSigningKeys = errors.New("no signing keys requested")
+)
+
+//SignRequest asks a Service to sign Transaction with SigningKeys.
+type SignRequest struct {
+	Transaction *types.SignedTransaction `json:"transaction"`
+	SigningKeys types.PublicKeys         `json:"signing_keys"`
+}
+
+//SignResponse holds the signed transaction. Its expiration may have been adjusted to get canonical signatures.
+type SignResponse struct {
+	Transaction *types.SignedTransaction `json:"transaction,omitempty"`
+	Error       string                   `json:"error,omitempty"`
+}
+
+//AuditEntry is a line of the audit log.
+type AuditEntry struct {
+	Time        time.Time `json:"time"`
+	Remote      string    `json:"remote,omitempty"`
+	Operations  []string  `json:"operations"`
+	SigningKeys []string  `json:"signing_keys"`
+	Signed      bool      `json:"signed"`
+	Error       string    `json:"error,omitempty"`
+}
+
+//Service signs transactions with the keys of a crypto.Signer if its Policy allows them.
+type Service struct {
+	signer crypto.Signer
+	policy *Policy
+	now    func() time.Time
+
+	auditMu sync.Mutex
+	audit   io.Writer
+}
+
+//NewService creates a Service signing with signer, usually a *crypto.KeyBag.
+//Every request is logged as a JSON line to audit, which may be nil.
+func NewService(signer crypto.Signer, policy *Policy, audit io.Writer) *Service {
+	return &Service{
+		signer: signer,
+		policy: policy,
+		audit:  audit,
+		now:    time.Now,
+	}
+}
+
+//LoadPolicy reads a JSON encoded Policy from path.
+func LoadPolicy(path string) (*Policy, error) {
+	inFile, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Errorf("load policy [%s], %s", path, err)
+	}
+	defer inFile.Close()
+
+	data, err := ioutil.ReadAll(inFile)
+	if err != nil {
+		return nil, errors.Annotate(err, "ReadAll")
+	}
+
+	policy := Policy{}
+	if err := ffjson.Unmarshal(data, &policy); err != nil {
+		return nil, errors.Annotate(err, "Decode [Policy]")
+	}
+
+	return &policy, nil
+}
+
+//Publics returns the public keys the Service can sign with.
+func (p *Service) Publics() types.PublicKeys {
+	return p.signer.Publics()
+}
+
+//Sign checks req against the policy and signs its transaction.
+//remote identifies the requester in the audit log.
+func (p *Service) Sign(req *SignRequest, remote string) (*types.SignedTransaction, error) {
+	entry := AuditEntry{
+		Time:   p.now().UTC(),
+		Remote: remote,
+	}
+
+	err := p.sign(req, &entry)
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	p.writeAudit(&entry)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return req.Transaction, nil
+}
+
+func (p *Service) sign(req *SignRequest, entry *AuditEntry) error {
+	if req.Transaction == nil {
+		return errors.New("no transaction")
+	}
+
+	for _, op := range req.Transaction.Operations {
+		if op == nil {
+			entry.Operations = append(entry.Operations, "unknown")
+			continue
+		}
+		entry.Operations = append(entry.Operations, op.Type().String())
+	}
+
+	for _, pub := range req.SigningKeys {
+		entry.SigningKeys = append(entry.SigningKeys, pub.String())
+	}
+
+	if len(req.SigningKeys) == 0 {
+		return ErrNoSigningKeys
+	}
+
+	keys := crypto.SignerKeys(p.signer, req.SigningKeys)
+	if len(keys) != len(req.SigningKeys) {
+		return types.ErrNoSigningKeyFound
+	}
+
+	return p.policy.Apply(req.Transaction, entry.Time, func() error {
+		txSigner := crypto.NewTransactionSigner(req.Transaction)
+		if err := txSigner.SignWith(p.signer, keys, config.Current()); err != nil {
+			return errors.Annotate(err, "SignWith")
+		}
+
+		entry.Signed = true
+		return nil
+	})
+}
+
+func (p *Service) writeAudit(entry *AuditEntry) {
+	if p.audit == nil {
+		return
+	}
+
+	data, err := ffjson.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	p.auditMu.Lock()
+	defer p.auditMu.Unlock()
+	p.audit.Write(append(data, '\n'))
+}
@@ -0,0 +1,260 @@
+package signer
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/denkhaus/bitshares/config"
+	"github.com/denkhaus/bitshares/crypto"
+	"github.com/denkhaus/bitshares/operations"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func amount(asset string, value int64) types.AssetAmount {
+	return types.AssetAmount{
+		Asset:  types.AssetIDFromObject(types.NewAssetID(asset)),
+		Amount: types.Int64(value),
+	}
+}
+
+func transferTx(to string, value int64) *types.SignedTransaction {
+	tx := types.NewSignedTransaction()
+	tx.Expiration.Set(time.Minute)
+	tx.Operations = types.Operations{
+		&operations.TransferOperation{
+			OperationFee: types.OperationFee{Fee: &types.AssetAmount{
+				Asset: types.AssetIDFromObject(types.NewAssetID("1.3.0")),
+			}},
+			From:       types.AccountIDFromObject(types.NewAccountID("1.2.100")),
+			To:         types.AccountIDFromObject(types.NewAccountID(to)),
+			Amount:     amount("1.3.0", value),
+			Extensions: types.Extensions{},
+		},
+	}
+
+	return tx
+}
+
+func withFee(tx *types.SignedTransaction, asset string, value int64) *types.SignedTransaction {
+	for _, op := range tx.Operations {
+		op.SetFee(amount(asset, value))
+	}
+
+	return tx
+}
+
+func testService(t *testing.T, audit io.Writer) (*Service, *crypto.KeyBag) {
+	config.SetCurrent(config.ChainIDGPH)
+
+	priv, err := types.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bag := crypto.NewKeyBag()
+	if err := bag.Add(priv.ToWIF()); err != nil {
+		t.Fatal(err)
+	}
+
+	policy := Policy{
+		AllowedOperations: []types.OperationType{
+			types.OperationTypeTransfer,
+			types.OperationTypeLimitOrderCreate,
+		},
+		AllowedRecipients:   []string{"1.2.200"},
+		DailyTransferLimits: map[string]types.Int64{"1.3.0": 1000},
+		MaxOrderSize:        map[string]types.Int64{"1.3.0": 500},
+		MaxFees:             map[string]types.Int64{"1.3.0": 50},
+	}
+
+	return NewService(bag, &policy, audit), bag
+}
+
+func TestServicePolicy(t *testing.T) {
+	var audit bytes.Buffer
+	service, bag := testService(t, &audit)
+	keys := bag.Publics()
+
+	day := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	service.now = func() time.Time { return day }
+
+	_, err := service.Sign(&SignRequest{Transaction: transferTx("1.2.200", 600)}, "test")
+	assert.Equal(t, ErrNoSigningKeys, err)
+
+	tx, err := service.Sign(&SignRequest{Transaction: transferTx("1.2.200", 600), SigningKeys: keys}, "test")
+	if assert.NoError(t, err) {
+		assert.Len(t, tx.Signatures, 1)
+		verified, err := crypto.VerifySignedTransaction(bag, tx)
+		assert.NoError(t, err)
+		assert.True(t, verified)
+	}
+
+	_, err = service.Sign(&SignRequest{Transaction: transferTx("1.2.300", 100), SigningKeys: keys}, "test")
+	assert.True(t, IsPolicyError(err), "recipient not allowed")
+
+	_, err = service.Sign(&SignRequest{Transaction: transferTx("1.2.200", 500), SigningKeys: keys}, "test")
+	assert.True(t, IsPolicyError(err), "daily limit exceeded")
+
+	order := types.NewSignedTransaction()
+	order.Operations = types.Operations{
+		&operations.LimitOrderCreateOperation{
+			AmountToSell: amount("1.3.0", 501),
+			MinToReceive: amount("1.3.113", 1),
+		},
+	}
+	_, err = service.Sign(&SignRequest{Transaction: order, SigningKeys: keys}, "test")
+	assert.True(t, IsPolicyError(err), "order too big")
+
+	cancel := types.NewSignedTransaction()
+	cancel.Operations = types.Operations{&operations.LimitOrderCancelOperation{}}
+	_, err = service.Sign(&SignRequest{Transaction: cancel, SigningKeys: keys}, "test")
+	assert.True(t, IsPolicyError(err), "operation not allowed")
+
+	// fees are capped and only accepted in listed assets
+	_, err = service.Sign(&SignRequest{Transaction: withFee(transferTx("1.2.200", 1), "1.3.0", 51), SigningKeys: keys}, "test")
+	assert.True(t, IsPolicyError(err), "fee too high")
+
+	_, err = service.Sign(&SignRequest{Transaction: withFee(transferTx("1.2.200", 1), "1.3.113", 1), SigningKeys: keys}, "test")
+	assert.True(t, IsPolicyError(err), "fee asset not allowed")
+
+	// the limit resets the next day, fees count toward it
+	day = day.Add(24 * time.Hour)
+	_, err = service.Sign(&SignRequest{Transaction: withFee(transferTx("1.2.200", 500), "1.3.0", 20), SigningKeys: keys}, "test")
+	assert.NoError(t, err)
+	assert.Equal(t, types.Int64(520), service.policy.Spent(day)["1.3.0"])
+
+	_, err = service.Sign(&SignRequest{Transaction: withFee(transferTx("1.2.200", 470), "1.3.0", 20), SigningKeys: keys}, "test")
+	assert.True(t, IsPolicyError(err), "daily limit exceeded by fees")
+
+	lines := bytes.Split(bytes.TrimSpace(audit.Bytes()), []byte("\n"))
+	assert.Len(t, lines, 10)
+	assert.Contains(t, string(lines[1]), `"signed":true`)
+	assert.Contains(t, string(lines[2]), `recipient 1.2.300 not allowed`)
+}
+
+func TestServerUnixSocket(t *testing.T) {
+	service, bag := testService(t, nil)
+
+	dir, err := ioutil.TempDir("", "signer")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	socket := filepath.Join(dir, "signer.sock")
+	server := NewServer(service)
+	if !assert.NoError(t, server.Listen("unix", socket)) {
+		return
+	}
+
+	info, err := os.Stat(socket)
+	if assert.NoError(t, err) {
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	}
+
+	go server.Serve()
+	defer server.Close()
+
+	client := NewClient("unix", socket)
+	keys, err := client.Publics()
+	if assert.NoError(t, err) && assert.Len(t, keys, 1) {
+		assert.True(t, keys[0].Equal(&bag.Publics()[0]))
+	}
+
+	tx, err := client.SignTransaction(transferTx("1.2.200", 100), keys)
+	if assert.NoError(t, err) {
+		verified, err := crypto.VerifySignedTransaction(bag, tx)
+		assert.NoError(t, err)
+		assert.True(t, verified)
+	}
+
+	_, err = client.SignTransaction(transferTx("1.2.300", 100), keys)
+	assert.True(t, IsPolicyError(err))
+}
+
+func TestPolicyPersist(t *testing.T) {
+	dir, err := ioutil.TempDir("", "signer")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	state := filepath.Join(dir, "spent.json")
+	day := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	service, bag := testService(t, nil)
+	service.now = func() time.Time { return day }
+	if !assert.NoError(t, service.policy.Persist(state)) {
+		return
+	}
+
+	_, err = service.Sign(&SignRequest{Transaction: transferTx("1.2.200", 600), SigningKeys: bag.Publics()}, "test")
+	assert.NoError(t, err)
+
+	// a restarted service continues with the spend of the day
+	restarted := Policy{DailyTransferLimits: map[string]types.Int64{"1.3.0": 1000}}
+	if assert.NoError(t, restarted.Persist(state)) {
+		assert.Equal(t, types.Int64(600), restarted.Spent(day)["1.3.0"])
+		assert.Empty(t, restarted.Spent(day.Add(24*time.Hour)))
+	}
+}
+
+func TestServerTCPToken(t *testing.T) {
+	service, bag := testService(t, nil)
+
+	server := NewServer(service)
+	for _, network := range []string{"tcp", "tcp4", "tcp6"} {
+		assert.Equal(t, ErrInsecureListener, server.Listen(network, "0.0.0.0:0"), network)
+		assert.Equal(t, ErrInsecureListener, server.Listen(network, "127.0.0.1:0"), network)
+	}
+
+	server.SetToken("secret")
+	if !assert.NoError(t, server.Listen("tcp", "127.0.0.1:0")) {
+		return
+	}
+
+	go server.Serve()
+	defer server.Close()
+
+	address := server.Addr().String()
+	client := NewClient("tcp", address)
+	_, err := client.Publics()
+	assert.Error(t, err)
+
+	client.SetToken("secret")
+	keys, err := client.Publics()
+	if assert.NoError(t, err) && assert.Len(t, keys, 1) {
+		assert.True(t, keys[0].Equal(&bag.Publics()[0]))
+	}
+
+	// foreign host names (DNS rebinding) and browser requests are refused
+	for _, header := range []struct{ host, origin string }{
+		{"signer.example.com:" + address[strings.LastIndex(address, ":")+1:], ""},
+		{address, "http://example.com"},
+	} {
+		req, err := http.NewRequest(http.MethodGet, "http://"+address+PathKeys, nil)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		req.Host = header.host
+		req.Header.Set("Authorization", "Bearer secret")
+		if header.origin != "" {
+			req.Header.Set("Origin", header.origin)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if assert.NoError(t, err) {
+			assert.Equal(t, http.StatusForbidden, resp.StatusCode, header.host)
+			resp.Body.Close()
+		}
+	}
+}
@@ -209,6 +209,86 @@ func (suite *commonTest) Test_GetMarginPositions() {
 	//logging.Dump("marginpositions >", res)
 }
 
+func (suite *commonTest) Test_GetProposedTransactions() {
+	res, err := suite.TestAPI.GetProposedTransactions(UserID2)
+	if err != nil {
+		suite.FailNow(err.Error(), "GetProposedTransactions")
+	}
+
+	suite.NotNil(res)
+
+	for idx := range res {
+		_, err := suite.TestAPI.CheckProposal(&res[idx])
+		suite.NoError(err, "CheckProposal")
+	}
+}
+
+func (suite *commonTest) Test_GetVoteObjects() {
+	props, err := suite.TestAPI.GetGlobalProperties()
+	if err != nil {
+		suite.FailNow(err.Error(), "GetGlobalProperties")
+	}
+
+	witnessIDs := make([]types.GrapheneObject, 0, len(props.ActiveWitnesses))
+	for idx := range props.ActiveWitnesses {
+		witnessIDs = append(witnessIDs, &props.ActiveWitnesses[idx])
+	}
+
+	witnesses, err := suite.TestAPI.GetWitnesses(witnessIDs...)
+	if err != nil {
+		suite.FailNow(err.Error(), "GetWitnesses")
+	}
+
+	suite.Len(witnesses, len(witnessIDs))
+
+	committee, err := suite.TestAPI.GetCommitteeMembers(CommitteeMember1)
+	if err != nil {
+		suite.FailNow(err.Error(), "GetCommitteeMembers")
+	}
+
+	workers, err := suite.TestAPI.GetAllWorkers()
+	if err != nil {
+		suite.FailNow(err.Error(), "GetAllWorkers")
+	}
+
+	if suite.NotEmpty(witnesses) && suite.NotEmpty(committee) {
+		res, err := suite.TestAPI.LookupVoteIDs(witnesses[0].VoteID, committee[0].VoteID)
+		if err != nil {
+			suite.FailNow(err.Error(), "LookupVoteIDs")
+		}
+
+		suite.IsType(types.Witness{}, res[0])
+		suite.IsType(types.CommitteeMember{}, res[1])
+	}
+
+	suite.NotNil(workers)
+}
+
+func (suite *commonTest) Test_LookupVoteAccounts() {
+	witnesses, err := suite.TestAPI.LookupWitnessAccounts("", 10)
+	if err != nil {
+		suite.FailNow(err.Error(), "LookupWitnessAccounts")
+	}
+
+	suite.NotEmpty(witnesses)
+
+	committee, err := suite.TestAPI.LookupCommitteeMemberAccounts("", 10)
+	if err != nil {
+		suite.FailNow(err.Error(), "LookupCommitteeMemberAccounts")
+	}
+
+	suite.NotEmpty(committee)
+
+	if len(witnesses) > 0 {
+		res, err := suite.TestAPI.GetWitnesses(&witnesses[0].ID)
+		if err != nil {
+			suite.FailNow(err.Error(), "GetWitnesses")
+		}
+
+		suite.Len(res, 1)
+	}
+}
+
 func (suite *commonTest) Test_GetForceSettlementOrders() {
 	res, err := suite.TestAPI.GetForceSettlementOrders(AssetCNY, 50)
 	if err != nil {
@@ -261,6 +341,72 @@ func (suite *commonTest) Test_GetAccountHistory_1() {
 	//logging.Dump("history >", res)
 }
 
+func (suite *commonTest) Test_GetAccountHistoryOperations() {
+	start := types.NewOperationHistoryID("1.11.0")
+	stop := types.NewOperationHistoryID("1.11.0")
+
+	res, err := suite.TestAPI.GetAccountHistoryOperations(UserID2,
+		types.OperationTypeTransfer, start, stop, 10,
+	)
+	if err != nil {
+		suite.FailNow(err.Error(), "GetAccountHistoryOperations")
+	}
+
+	suite.NotNil(res)
+	for _, hist := range res {
+		suite.Equal(types.OperationTypeTransfer, hist.Operation.Type)
+	}
+}
+
+func (suite *commonTest) Test_GetAccountHistoryByOperations() {
+	res, err := suite.TestAPI.GetAccountHistoryByOperations(UserID2,
+		[]types.OperationType{types.OperationTypeTransfer}, 0, 10,
+	)
+	if err != nil {
+		suite.FailNow(err.Error(), "GetAccountHistoryByOperations")
+	}
+
+	suite.NotNil(res)
+}
+
+func (suite *commonTest) Test_GetRelativeAccountHistory() {
+	res, err := suite.TestAPI.GetRelativeAccountHistory(UserID2, 1, 10, 10)
+	if err != nil {
+		suite.FailNow(err.Error(), "GetRelativeAccountHistory")
+	}
+
+	suite.NotNil(res)
+	suite.Len(res, 10)
+}
+
+func (suite *commonTest) Test_GetFillOrderHistory() {
+	res, err := suite.TestAPI.GetFillOrderHistory(AssetCNY, AssetBTS, 20)
+	if err != nil {
+		suite.FailNow(err.Error(), "GetFillOrderHistory")
+	}
+
+	suite.NotNil(res)
+}
+
+func (suite *commonTest) Test_GetMarketHistory() {
+	buckets, err := suite.TestAPI.GetMarketHistoryBuckets()
+	if err != nil {
+		suite.FailNow(err.Error(), "GetMarketHistoryBuckets")
+	}
+
+	suite.NotEmpty(buckets)
+
+	end := time.Now()
+	start := end.Add(-24 * time.Hour)
+
+	res, err := suite.TestAPI.GetMarketHistory(AssetCNY, AssetBTS, 3600, start, end)
+	if err != nil {
+		suite.FailNow(err.Error(), "GetMarketHistory")
+	}
+
+	suite.NotNil(res)
+}
+
 func (suite *commonTest) Test_GetOrderBook() {
 	res, err := suite.TestAPI.GetOrderBook(AssetUSD, AssetBTS, 10)
 	if err != nil {
@@ -0,0 +1,143 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/denkhaus/bitshares"
+	"github.com/stretchr/testify/suite"
+
+	//import operations to initialize types.OperationMap
+	_ "github.com/denkhaus/bitshares/operations"
+)
+
+type iteratorTest struct {
+	suite.Suite
+	TestAPI bitshares.WebsocketAPI
+}
+
+func (suite *iteratorTest) SetupTest() {
+	suite.TestAPI = NewWebsocketTestAPI(
+		suite.T(),
+		WsFullApiUrl,
+	)
+}
+
+func (suite *iteratorTest) TearDownTest() {
+	if err := suite.TestAPI.Close(); err != nil {
+		suite.FailNow(err.Error(), "Close")
+	}
+}
+
+func (suite *iteratorTest) Test_AccountHistoryIteratorBackward() {
+	it := bitshares.NewAccountHistoryIterator(suite.TestAPI, UserID2,
+		bitshares.HistoryBackward, &bitshares.IteratorOptions{
+			PageSize: 10,
+			Prefetch: 2,
+		},
+	)
+	defer it.Close()
+
+	var last uint64
+	for cnt := 0; cnt < 35 && it.Next(); cnt++ {
+		id := uint64(it.Value().ID.Instance())
+		if last > 0 {
+			suite.True(id < last, "history must be ordered backward")
+		}
+		last = id
+	}
+
+	suite.Nil(it.Err())
+	suite.NotEmpty(it.Cursor())
+}
+
+func (suite *iteratorTest) Test_AccountHistoryIteratorForward() {
+	it := bitshares.NewAccountHistoryIterator(suite.TestAPI, UserID2,
+		bitshares.HistoryForward, &bitshares.IteratorOptions{
+			PageSize: 10,
+		},
+	)
+	defer it.Close()
+
+	var last uint64
+	for cnt := 0; cnt < 25 && it.Next(); cnt++ {
+		id := uint64(it.Value().ID.Instance())
+		suite.True(id > last, "history must be ordered forward")
+		last = id
+	}
+
+	suite.Nil(it.Err())
+	suite.Equal("25", it.Cursor())
+
+	// resume after the last delivered item
+	resumed := bitshares.NewAccountHistoryIterator(suite.TestAPI, UserID2,
+		bitshares.HistoryForward, &bitshares.IteratorOptions{
+			Cursor: it.Cursor(),
+		},
+	)
+	defer resumed.Close()
+
+	suite.True(resumed.Next())
+	suite.True(uint64(resumed.Value().ID.Instance()) > last)
+	suite.Equal("26", resumed.Cursor())
+}
+
+func (suite *iteratorTest) Test_AssetIterator() {
+	it := bitshares.NewAssetIterator(suite.TestAPI, &bitshares.IteratorOptions{
+		PageSize: 20,
+		Cursor:   "OPEN.",
+	})
+	defer it.Close()
+
+	var last string
+	for cnt := 0; cnt < 50 && it.Next(); cnt++ {
+		symbol := it.Value().Symbol.String()
+		suite.True(symbol > last, "assets must be ordered and unique")
+		last = symbol
+	}
+
+	suite.Nil(it.Err())
+	suite.Equal(last, it.Cursor())
+}
+
+func (suite *iteratorTest) Test_AccountIterator() {
+	it := bitshares.NewAccountIterator(suite.TestAPI, &bitshares.IteratorOptions{
+		PageSize: 100,
+		Prefetch: 1,
+		Cursor:   "open",
+	})
+	defer it.Close()
+
+	var last string
+	for cnt := 0; cnt < 250 && it.Next(); cnt++ {
+		name := it.Value().Name.String()
+		suite.True(name > last, "accounts must be ordered and unique")
+		last = name
+	}
+
+	suite.Nil(it.Err())
+}
+
+func (suite *iteratorTest) Test_LimitOrderIterator() {
+	it := bitshares.NewLimitOrderIterator(suite.TestAPI, AssetCNY, AssetBTS,
+		&bitshares.IteratorOptions{
+			PageSize: 50,
+		},
+	)
+	defer it.Close()
+
+	//the iteration stops at the depth limit of both sides
+	cnt := 0
+	for ; cnt <= 2*bitshares.GetLimitOrdersMaxDepth && it.Next(); cnt++ {
+		suite.NotNil(it.Value())
+	}
+
+	suite.Nil(it.Err())
+	suite.True(cnt > 0)
+	suite.True(cnt <= 2*bitshares.GetLimitOrdersMaxDepth)
+	suite.False(it.Next())
+}
+
+func TestIterator(t *testing.T) {
+	testSuite := new(iteratorTest)
+	suite.Run(t, testSuite)
+}
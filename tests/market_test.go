@@ -0,0 +1,102 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/denkhaus/bitshares"
+	"github.com/denkhaus/bitshares/market"
+	"github.com/stretchr/testify/suite"
+
+	//import operations to initialize types.OperationMap
+	_ "github.com/denkhaus/bitshares/operations"
+)
+
+type marketTest struct {
+	suite.Suite
+	TestAPI bitshares.WebsocketAPI
+}
+
+func (suite *marketTest) SetupTest() {
+	suite.TestAPI = NewWebsocketTestAPI(
+		suite.T(),
+		WsFullApiUrl,
+	)
+}
+
+func (suite *marketTest) TearDownTest() {
+	if err := suite.TestAPI.Close(); err != nil {
+		suite.FailNow(err.Error(), "Close")
+	}
+}
+
+func (suite *marketTest) Test_FetchCandlesFromBuckets() {
+	mkt, err := market.NewMarket(suite.TestAPI, AssetCNY, AssetBTS)
+	if err != nil {
+		suite.FailNow(err.Error(), "NewMarket")
+	}
+
+	end := time.Now()
+	start := end.Add(-24 * time.Hour)
+
+	candles, err := market.FetchCandles(suite.TestAPI, *mkt, 4*time.Hour, start, end)
+	if err != nil {
+		suite.FailNow(err.Error(), "FetchCandles")
+	}
+
+	for idx := 1; idx < len(candles); idx++ {
+		suite.Equal(candles[idx-1].End(), candles[idx].Open)
+	}
+}
+
+func (suite *marketTest) Test_FetchCandlesFromTrades() {
+	mkt, err := market.NewMarket(suite.TestAPI, AssetCNY, AssetBTS)
+	if err != nil {
+		suite.FailNow(err.Error(), "NewMarket")
+	}
+
+	end := time.Now()
+	start := end.Add(-time.Hour)
+
+	// no bucket size divides 7 minutes
+	candles, err := market.FetchCandles(suite.TestAPI, *mkt, 7*time.Minute, start, end)
+	if err != nil {
+		suite.FailNow(err.Error(), "FetchCandles")
+	}
+
+	for _, candle := range candles {
+		suite.True(candle.Low.Cmp(candle.High) <= 0)
+	}
+}
+
+func (suite *marketTest) Test_OrderBook() {
+	mkt, err := market.NewMarket(suite.TestAPI, AssetCNY, AssetBTS)
+	if err != nil {
+		suite.FailNow(err.Error(), "NewMarket")
+	}
+
+	book := market.NewOrderBook(suite.TestAPI, *mkt, &market.OrderBookOptions{
+		Depth: 50,
+	})
+
+	if err := book.Start(); err != nil {
+		suite.FailNow(err.Error(), "Start")
+	}
+
+	suite.True(book.Synced())
+
+	bid, okBid := book.BestBid()
+	ask, okAsk := book.BestAsk()
+	if okBid && okAsk {
+		suite.True(bid.Price.Cmp(ask.Price) < 0, "book must not be crossed")
+	}
+
+	if err := book.Stop(); err != nil {
+		suite.FailNow(err.Error(), "Stop")
+	}
+}
+
+func TestMarket(t *testing.T) {
+	testSuite := new(marketTest)
+	suite.Run(t, testSuite)
+}
@@ -2,12 +2,14 @@ package tests
 
 import (
 	"testing"
+	"time"
 
 	"github.com/denkhaus/bitshares"
 	"github.com/denkhaus/bitshares/config"
 	"github.com/denkhaus/bitshares/crypto"
 	"github.com/denkhaus/bitshares/operations"
 	"github.com/denkhaus/bitshares/types"
+	"github.com/juju/errors"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -173,6 +175,101 @@ func (suite *websocketAPITest) Test_Transfer() {
 		suite.FailNow(err.Error(), "BroadcastTransaction")
 	}
 }
+
+func (suite *websocketAPITest) Test_TxBuilder() {
+	op := operations.TransferOperation{
+		Extensions: types.Extensions{},
+		Amount: types.AssetAmount{
+			Amount: 1000,
+			Asset:  types.AssetIDFromObject(AssetTEST),
+		},
+		From: types.AccountIDFromObject(TestAccount2ID),
+		To:   types.AccountIDFromObject(TestAccount1ID),
+	}
+
+	trx, err := suite.WebsocketAPI.NewTxBuilder().
+		FeeAsset(AssetTEST).
+		Add(&op).
+		Expiration(time.Minute).
+		Sign(suite.KeyBag)
+	if err != nil {
+		suite.FailNow(err.Error(), "Sign")
+	}
+
+	suite.Len(trx.Signatures, 1)
+	suite.compareTransaction(trx, false)
+
+	proposal, err := suite.WebsocketAPI.NewTxBuilder().
+		Add(&op).
+		Propose(TestAccount2ID, time.Hour).
+		ReviewPeriod(10 * time.Minute).
+		Build()
+	if err != nil {
+		suite.FailNow(err.Error(), "Build [proposal]")
+	}
+
+	if suite.Len(proposal.Operations, 1) {
+		create := proposal.Operations[0].(*operations.ProposalCreateOperation)
+		suite.Len(create.ProposedOps, 1)
+		suite.NotNil(create.ReviewPeriodSeconds)
+	}
+
+	_, err = suite.WebsocketAPI.NewTxBuilder().Build()
+	suite.Equal(bitshares.ErrNoOperations, err)
+}
+
+func (suite *websocketAPITest) Test_RegisterAccount() {
+	keys, err := crypto.DerivePasswordKeys("denk-haus-2", "secret")
+	if err != nil {
+		suite.FailNow(err.Error(), "DerivePasswordKeys")
+	}
+
+	pub := *keys.Active.PublicKey()
+	err = suite.WebsocketAPI.RegisterAccount(suite.KeyBag, TestAccount1ID, TestAccount1ID, AssetTEST,
+		"Denk_Haus", pub, pub, pub, 0)
+	suite.Equal(types.ErrInvalidAccountName, errors.Cause(err))
+
+	err = suite.WebsocketAPI.RegisterAccount(suite.KeyBag, TestAccount1ID, TestAccount1ID, AssetTEST,
+		"denk-haus-2", pub, pub, pub, bitshares.FullReferrerPercent+1)
+	suite.Equal(bitshares.ErrInvalidReferrerPercent, err)
+}
+
+func (suite *websocketAPITest) Test_UpdateAccountAuthorities() {
+	accts, err := suite.WebsocketAPI.GetAccounts(TestAccount1ID)
+	if err != nil {
+		suite.FailNow(err.Error(), "GetAccounts")
+	}
+
+	//requiring the committee account on top of the active key of the KeyBag is refused
+	update := crypto.NewAuthorityUpdate(&accts[0]).
+		AddAccount(crypto.KeyRoleActive, types.NewAccountID("1.2.0"), 1).
+		SetThreshold(crypto.KeyRoleActive, accts[0].Active.WeightThreshold+1)
+
+	err = suite.WebsocketAPI.UpdateAccountAuthorities(suite.KeyBag, update, AssetTEST)
+	suite.Equal(crypto.ErrAuthorityLockout, errors.Cause(err))
+}
+
+func (suite *websocketAPITest) Test_UpdateAccountVotes() {
+	accts, err := suite.WebsocketAPI.GetAccounts(TestAccount1ID)
+	if err != nil {
+		suite.FailNow(err.Error(), "GetAccounts")
+	}
+
+	update := crypto.NewVoteUpdate(&accts[0]).
+		RemoveVotes(accts[0].Options.Votes...).
+		SetNumWitness(1)
+
+	_, err = update.Operation()
+	suite.Equal(crypto.ErrTooFewVotes, err)
+
+	_, err = crypto.NewVoteUpdate(&accts[0]).Operation()
+	suite.Equal(crypto.ErrNoVoteChanges, err)
+
+	err = suite.WebsocketAPI.UpdateAccountVotes(suite.KeyBag,
+		crypto.NewVoteUpdate(&accts[0]).AddVotes(*types.NewVoteID("1:999999")), AssetTEST)
+	suite.Equal(crypto.ErrUnknownVoteID, errors.Cause(err))
+}
+
 func (suite *websocketAPITest) Test_GetAccountBalances() {
 	res, err := suite.WebsocketAPI.GetAccountBalances(TestAccount1ID, AssetTEST)
 	if err != nil {
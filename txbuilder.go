@@ -0,0 +1,255 @@
+package bitshares
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/denkhaus/bitshares/crypto"
+	"github.com/denkhaus/bitshares/operations"
+	"github.com/denkhaus/bitshares/types"
+	"github.com/denkhaus/bitshares/util"
+	"github.com/juju/errors"
+)
+
+var (
+	ErrNoOperations          = errors.New("transaction has no operations")
+	ErrTransactionTooLarge   = errors.New("transaction exceeds maximum_transaction_size")
+	ErrExpirationTooLong     = errors.New("expiration exceeds maximum_time_until_expiration")
+	ErrProposalLifetime      = errors.New("proposal lifetime exceeds maximum_proposal_lifetime")
+	ErrProposalReviewTooLong = errors.New("review period must end before the proposal expires")
+)
+
+// TxBuilder is a transaction factory
+type TxBuilder struct {
+	api        WebsocketAPI
+	ops        types.Operations
+	feeAssets  []types.GrapheneObject
+	feeAsset   types.GrapheneObject
+	expiration time.Duration
+	proposal   *txProposal
+}
+
+type txProposal struct {
+	feePayingAccount types.GrapheneObject
+	lifetime         time.Duration
+	review           *time.Duration
+}
+
+//NewTxBuilder creates a new TxBuilder paying fees in the core asset by default.
+func (p *websocketAPI) NewTxBuilder() *TxBuilder {
+	builder := TxBuilder{
+		api:        p,
+		feeAsset:   types.NewAssetID("1.3.0"),
+		expiration: types.TxExpirationDefault,
+	}
+
+	return &builder
+}
+
+//FeeAsset sets the fee asset of operations added afterwards and of the proposal.
+func (p *TxBuilder) FeeAsset(asset types.GrapheneObject) *TxBuilder {
+	p.feeAsset = asset
+	return p
+}
+
+//Add appends ops paying fees in the current fee asset.
+func (p *TxBuilder) Add(ops ...types.Operation) *TxBuilder {
+	for _, op := range ops {
+		p.AddWithFee(op, p.feeAsset)
+	}
+
+	return p
+}
+
+//AddWithFee appends op paying its fee in feeAsset.
+func (p *TxBuilder) AddWithFee(op types.Operation, feeAsset types.GrapheneObject) *TxBuilder {
+	p.ops = append(p.ops, op)
+	p.feeAssets = append(p.feeAssets, feeAsset)
+	return p
+}
+
+//Expiration sets how long after the head block time the transaction is valid.
+func (p *TxBuilder) Expiration(expiration time.Duration) *TxBuilder {
+	p.expiration = expiration
+	return p
+}
+
+//Propose wraps all operations into a ProposalCreateOperation paid by feePayingAccount,
+//that expires after lifetime unless approved.
+func (p *TxBuilder) Propose(feePayingAccount types.GrapheneObject, lifetime time.Duration) *TxBuilder {
+	p.proposal = &txProposal{
+		feePayingAccount: feePayingAccount,
+		lifetime:         lifetime,
+	}
+
+	return p
+}
+
+//ReviewPeriod sets the review period of the proposal, required for proposals the committee has to approve.
+//It has no effect without Propose.
+func (p *TxBuilder) ReviewPeriod(review time.Duration) *TxBuilder {
+	if p.proposal != nil {
+		p.proposal.review = &review
+	}
+
+	return p
+}
+
+//Build returns the unsigned transaction with fees and block data applied.
+func (p *TxBuilder) Build() (*types.SignedTransaction, error) {
+	tx, _, err := p.build()
+	return tx, err
+}
+
+//Sign builds the transaction and signs it with the required keys held by signer.
+func (p *TxBuilder) Sign(signer crypto.Signer) (*types.SignedTransaction, error) {
+	tx, params, err := p.build()
+	if err != nil {
+		return nil, errors.Annotate(err, "build")
+	}
+
+	if err := p.api.SignTransaction(signer, tx); err != nil {
+		return nil, errors.Annotate(err, "SignTransaction")
+	}
+
+	//the size limit applies to the transaction including its signatures
+	if err := checkTransactionSize(tx, params, true); err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+func (p *TxBuilder) build() (*types.SignedTransaction, *types.ChainParameters, error) {
+	if len(p.ops) == 0 {
+		return nil, nil, ErrNoOperations
+	}
+
+	if err := p.applyFees(); err != nil {
+		return nil, nil, errors.Annotate(err, "applyFees")
+	}
+
+	props, err := p.api.GetDynamicGlobalProperties()
+	if err != nil {
+		return nil, nil, errors.Annotate(err, "GetDynamicGlobalProperties")
+	}
+
+	global, err := p.api.GetGlobalProperties()
+	if err != nil {
+		return nil, nil, errors.Annotate(err, "GetGlobalProperties")
+	}
+
+	params := &global.Parameters
+	if p.expiration > time.Duration(params.MaximumTimeUntilExpiration)*time.Second {
+		return nil, nil, ErrExpirationTooLong
+	}
+
+	ops := p.ops
+	if p.proposal != nil {
+		op, err := p.propose(props, params)
+		if err != nil {
+			return nil, nil, errors.Annotate(err, "propose")
+		}
+
+		ops = types.Operations{op}
+	}
+
+	tx, err := types.NewSignedTransactionWithBlockData(props)
+	if err != nil {
+		return nil, nil, errors.Annotate(err, "NewTransaction")
+	}
+
+	tx.Operations = ops
+	tx.Expiration = props.Time.Add(p.expiration)
+
+	if err := checkTransactionSize(tx, params, false); err != nil {
+		return nil, nil, err
+	}
+
+	return tx, params, nil
+}
+
+//checkTransactionSize checks the serialized size of tx, with or without signatures, against the chain limit.
+func checkTransactionSize(tx *types.SignedTransaction, params *types.ChainParameters, signed bool) error {
+	var buf bytes.Buffer
+	enc := util.NewTypeEncoder(&buf)
+	if signed {
+		if err := enc.Encode(tx); err != nil {
+			return errors.Annotate(err, "encode SignedTransaction")
+		}
+	} else if err := enc.Encode(tx.Transaction); err != nil {
+		return errors.Annotate(err, "encode Transaction")
+	}
+
+	if buf.Len() > int(params.MaximumTransactionSize) {
+		return ErrTransactionTooLarge
+	}
+
+	return nil
+}
+
+//applyFees requests the fees of the operations grouped by fee asset.
+func (p *TxBuilder) applyFees() error {
+	groups := make(map[string][]int)
+	assets := make(map[string]types.GrapheneObject)
+	for idx, asset := range p.feeAssets {
+		groups[asset.ID()] = append(groups[asset.ID()], idx)
+		assets[asset.ID()] = asset
+	}
+
+	for id, idxs := range groups {
+		ops := make(types.Operations, 0, len(idxs))
+		for _, idx := range idxs {
+			ops = append(ops, p.ops[idx])
+		}
+
+		fees, err := p.api.GetRequiredFees(ops, assets[id])
+		if err != nil {
+			return errors.Annotate(err, "GetRequiredFees")
+		}
+
+		if err := ops.ApplyFees(fees); err != nil {
+			return errors.Annotate(err, "ApplyFees")
+		}
+	}
+
+	return nil
+}
+
+//propose wraps the operations, which already carry their fees, into a proposal.
+func (p *TxBuilder) propose(props *types.DynamicGlobalProperties, params *types.ChainParameters) (types.Operation, error) {
+	if p.proposal.lifetime > time.Duration(params.MaximumProposalLifetime)*time.Second {
+		return nil, ErrProposalLifetime
+	}
+
+	op := operations.ProposalCreateOperation{
+		ExpirationTime:   props.Time.Add(p.proposal.lifetime),
+		Extensions:       types.Extensions{},
+		FeePayingAccount: types.AccountIDFromObject(p.proposal.feePayingAccount),
+		ProposedOps:      make(types.OperationEnvelopeHolders, 0, len(p.ops)),
+	}
+
+	if review := p.proposal.review; review != nil {
+		if *review >= p.proposal.lifetime {
+			return nil, ErrProposalReviewTooLong
+		}
+
+		seconds := types.UInt32(*review / time.Second)
+		op.ReviewPeriodSeconds = &seconds
+	}
+
+	for _, env := range p.ops.Envelopes() {
+		op.ProposedOps = append(op.ProposedOps, types.OperationEnvelopeHolder{Op: env})
+	}
+
+	fees, err := p.api.GetRequiredFees(types.Operations{&op}, p.feeAsset)
+	if err != nil {
+		return nil, errors.Annotate(err, "GetRequiredFees")
+	}
+
+	if err := (types.Operations{&op}).ApplyFees(fees); err != nil {
+		return nil, errors.Annotate(err, "ApplyFees")
+	}
+
+	return &op, nil
+}
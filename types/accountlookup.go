@@ -0,0 +1,87 @@
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/juju/errors"
+	"github.com/pquerna/ffjson/ffjson"
+)
+
+type AccountLookups []AccountLookup
+
+//AccountLookup is a name to AccountID mapping as returned by lookup_accounts.
+type AccountLookup struct {
+	Name String
+	ID   AccountID
+}
+
+func (p AccountLookup) MarshalJSON() ([]byte, error) {
+	return ffjson.Marshal([]interface{}{
+		p.Name,
+		p.ID,
+	})
+}
+
+func (p *AccountLookup) UnmarshalJSON(data []byte) error {
+	return unmarshalLookup(data, &p.Name, &p.ID)
+}
+
+type WitnessLookups []WitnessLookup
+
+//WitnessLookup is an account name to WitnessID mapping as returned by lookup_witness_accounts.
+type WitnessLookup struct {
+	Name String
+	ID   WitnessID
+}
+
+func (p WitnessLookup) MarshalJSON() ([]byte, error) {
+	return ffjson.Marshal([]interface{}{
+		p.Name,
+		p.ID,
+	})
+}
+
+func (p *WitnessLookup) UnmarshalJSON(data []byte) error {
+	return unmarshalLookup(data, &p.Name, &p.ID)
+}
+
+type CommitteeMemberLookups []CommitteeMemberLookup
+
+//CommitteeMemberLookup is an account name to CommitteeMemberID mapping as returned by lookup_committee_member_accounts.
+type CommitteeMemberLookup struct {
+	Name String
+	ID   CommitteeMemberID
+}
+
+func (p CommitteeMemberLookup) MarshalJSON() ([]byte, error) {
+	return ffjson.Marshal([]interface{}{
+		p.Name,
+		p.ID,
+	})
+}
+
+func (p *CommitteeMemberLookup) UnmarshalJSON(data []byte) error {
+	return unmarshalLookup(data, &p.Name, &p.ID)
+}
+
+//unmarshalLookup decodes a [name, id] pair.
+func unmarshalLookup(data []byte, name, id interface{}) error {
+	raw := make([]json.RawMessage, 2)
+	if err := ffjson.Unmarshal(data, &raw); err != nil {
+		return errors.Annotate(err, "unmarshal [raw]")
+	}
+
+	if len(raw) != 2 {
+		return ErrInvalidInputLength
+	}
+
+	if err := ffjson.Unmarshal(raw[0], name); err != nil {
+		return errors.Annotate(err, "unmarshal [name]")
+	}
+
+	if err := ffjson.Unmarshal(raw[1], id); err != nil {
+		return errors.Annotate(err, "unmarshal [id]")
+	}
+
+	return nil
+}
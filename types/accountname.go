@@ -0,0 +1,78 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+const (
+	//MinAccountNameLength is GRAPHENE_MIN_ACCOUNT_NAME_LENGTH, applied to the name and each of its labels.
+	MinAccountNameLength = 1
+	//MaxAccountNameLength is GRAPHENE_MAX_ACCOUNT_NAME_LENGTH.
+	MaxAccountNameLength = 63
+)
+
+var (
+	ErrInvalidAccountName = errors.New("invalid account name")
+)
+
+//ValidateAccountName checks name against graphene's is_valid_name. Names consist of
+//dot separated labels starting with a lowercase letter, ending with a letter or digit
+//and containing lowercase letters, digits and dashes only.
+func ValidateAccountName(name string) error {
+	if len(name) < MinAccountNameLength || len(name) > MaxAccountNameLength {
+		return errors.Annotatef(ErrInvalidAccountName, "%q: length must be between %d and %d",
+			name, MinAccountNameLength, MaxAccountNameLength)
+	}
+
+	for _, label := range strings.Split(name, ".") {
+		if len(label) < MinAccountNameLength {
+			return errors.Annotatef(ErrInvalidAccountName, "%q: empty label", name)
+		}
+
+		if !isLower(label[0]) {
+			return errors.Annotatef(ErrInvalidAccountName, "%q: labels must start with a lowercase letter", name)
+		}
+
+		if last := label[len(label)-1]; !isLower(last) && !isDigit(last) {
+			return errors.Annotatef(ErrInvalidAccountName, "%q: labels must end with a lowercase letter or digit", name)
+		}
+
+		for idx := 1; idx < len(label)-1; idx++ {
+			if c := label[idx]; !isLower(c) && !isDigit(c) && c != '-' {
+				return errors.Annotatef(ErrInvalidAccountName, "%q: invalid character %q", name, c)
+			}
+		}
+	}
+
+	return nil
+}
+
+//IsPremiumAccountName returns true if registering name costs the premium fee, which is the case
+//for names without digits, dots, dashes or slashes that contain a vowel (the inverse of graphene's is_cheap_name).
+func IsPremiumAccountName(name string) bool {
+	vowel := false
+	for idx := 0; idx < len(name); idx++ {
+		switch c := name[idx]; c {
+		case '.', '-', '/':
+			return false
+		case 'a', 'e', 'i', 'o', 'u', 'y':
+			vowel = true
+		default:
+			if isDigit(c) {
+				return false
+			}
+		}
+	}
+
+	return vowel
+}
+
+func isLower(c byte) bool {
+	return c >= 'a' && c <= 'z'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
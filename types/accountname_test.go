@@ -0,0 +1,43 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateAccountName(t *testing.T) {
+	valid := []string{
+		"a", "init0", "denkhaus", "open-ledger", "bts.dex", "a1.b-2.c",
+		strings.Repeat("a", MaxAccountNameLength),
+	}
+
+	for _, name := range valid {
+		assert.NoError(t, ValidateAccountName(name), name)
+	}
+
+	invalid := []string{
+		"", "1abc", "Abc", "abc-", "ab_c", "abc.", ".abc", "ab..c", "bts.1dex",
+		strings.Repeat("a", MaxAccountNameLength+1),
+	}
+
+	for _, name := range invalid {
+		assert.Equal(t, ErrInvalidAccountName, errors.Cause(ValidateAccountName(name)), name)
+	}
+}
+
+func TestIsPremiumAccountName(t *testing.T) {
+	for name, premium := range map[string]bool{
+		"denkhaus":    true,
+		"bitshares":   true,
+		"xyz":         true,
+		"bcd":         false,
+		"denkhaus1":   false,
+		"open-ledger": false,
+		"bts.dex":     false,
+	} {
+		assert.Equal(t, premium, IsPremiumAccountName(name), name)
+	}
+}
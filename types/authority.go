@@ -18,6 +18,16 @@ type Authority struct {
 	AddressAuths    AddressAuthsMap `json:"address_auths"`
 }
 
+//NewKeyAuthority creates an Authority satisfied by a signature of pub.
+func NewKeyAuthority(pub PublicKey) Authority {
+	return Authority{
+		WeightThreshold: 1,
+		AccountAuths:    AccountAuthsMap{},
+		KeyAuths:        KeyAuthsMap{&pub: 1},
+		AddressAuths:    AddressAuthsMap{},
+	}
+}
+
 func (p Authority) Marshal(enc *util.TypeEncoder) error {
 	if err := enc.Encode(p.WeightThreshold); err != nil {
 		return errors.Annotate(err, "encode WeightThreshold")
@@ -2,9 +2,80 @@ package types
 
 //go:generate ffjson $GOFILE
 
+import (
+	"math/big"
+	"time"
+)
+
+//BalanceClaimInterval is the minimum time between two claims of a vesting balance.
+const BalanceClaimInterval = 24 * time.Hour
+
 type Balance struct {
-	ID            BalanceID   `json:"id"`
-	Balance       AssetAmount `json:"balance"`
-	LastClaimDate Time        `json:"last_claim_date"`
-	Owner         Address     `json:"owner"`
+	ID            BalanceID            `json:"id"`
+	Balance       AssetAmount          `json:"balance"`
+	VestingPolicy *BalanceVestingPolicy `json:"vesting_policy,omitempty"`
+	LastClaimDate Time                 `json:"last_claim_date"`
+	Owner         Address              `json:"owner"`
+}
+
+//BalanceVestingPolicy is the linear vesting state of a genesis balance. It releases
+//BeginBalance linearly over VestingDurationSeconds from BeginTimestamp on,
+//nothing before VestingCliffSeconds have passed.
+type BalanceVestingPolicy struct {
+	BeginTimestamp         Time   `json:"begin_timestamp"`
+	VestingCliffSeconds    UInt32 `json:"vesting_cliff_seconds"`
+	VestingDurationSeconds UInt32 `json:"vesting_duration_seconds"`
+	BeginBalance           Int64  `json:"begin_balance"`
+}
+
+//Available returns the amount of the balance claimable at now, like graphene's balance_object::available.
+func (p Balance) Available(now time.Time) AssetAmount {
+	ret := AssetAmount{Asset: p.Balance.Asset}
+	if p.VestingPolicy == nil {
+		ret.Amount = p.Balance.Amount
+		return ret
+	}
+
+	ret.Amount = p.VestingPolicy.allowedWithdraw(p.Balance.Amount, now)
+	return ret
+}
+
+//Claimable returns true if a claim at now is accepted, vesting balances can be claimed once per BalanceClaimInterval.
+func (p Balance) Claimable(now time.Time) bool {
+	if p.Available(now).Amount <= 0 {
+		return false
+	}
+
+	return p.VestingPolicy == nil || now.Sub(p.LastClaimDate.Time) >= BalanceClaimInterval
 }
+
+//allowedWithdraw mirrors linear_vesting_policy::get_allowed_withdraw.
+func (p BalanceVestingPolicy) allowedWithdraw(balance Int64, now time.Time) Int64 {
+	if !now.After(p.BeginTimestamp.Time) {
+		return 0
+	}
+
+	elapsed := int64(now.Sub(p.BeginTimestamp.Time) / time.Second)
+	if elapsed < int64(p.VestingCliffSeconds) {
+		return 0
+	}
+
+	vested := p.BeginBalance
+	if elapsed < int64(p.VestingDurationSeconds) {
+		v := new(big.Int).Mul(big.NewInt(int64(p.BeginBalance)), big.NewInt(elapsed))
+		vested = Int64(v.Quo(v, big.NewInt(int64(p.VestingDurationSeconds))).Int64())
+	}
+
+	withdrawn := p.BeginBalance - balance
+	return vested - withdrawn
+}
+
+type Balances []Balance
+
+//ClaimableBalance is a Balance together with the key owning it.
+type ClaimableBalance struct {
+	Balance Balance
+	Key     PublicKey
+}
+
+type ClaimableBalances []ClaimableBalance
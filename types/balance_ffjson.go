@@ -54,7 +54,23 @@ func (j *Balance) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 		}
 
 	}
-	buf.WriteString(`,"last_claim_date":`)
+	buf.WriteByte(',')
+	if j.VestingPolicy != nil {
+		if true {
+			buf.WriteString(`"vesting_policy":`)
+
+			{
+
+				err = j.VestingPolicy.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	buf.WriteString(`"last_claim_date":`)
 
 	{
 
@@ -88,6 +104,8 @@ const (
 
 	ffjtBalanceBalance
 
+	ffjtBalanceVestingPolicy
+
 	ffjtBalanceLastClaimDate
 
 	ffjtBalanceOwner
@@ -97,6 +115,8 @@ var ffjKeyBalanceID = []byte("id")
 
 var ffjKeyBalanceBalance = []byte("balance")
 
+var ffjKeyBalanceVestingPolicy = []byte("vesting_policy")
+
 var ffjKeyBalanceLastClaimDate = []byte("last_claim_date")
 
 var ffjKeyBalanceOwner = []byte("owner")
@@ -194,6 +214,14 @@ mainparse:
 						goto mainparse
 					}
 
+				case 'v':
+
+					if bytes.Equal(ffjKeyBalanceVestingPolicy, kn) {
+						currentKey = ffjtBalanceVestingPolicy
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
 				}
 
 				if fflib.SimpleLetterEqualFold(ffjKeyBalanceOwner, kn) {
@@ -208,6 +236,12 @@ mainparse:
 					goto mainparse
 				}
 
+				if fflib.EqualFoldRight(ffjKeyBalanceVestingPolicy, kn) {
+					currentKey = ffjtBalanceVestingPolicy
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
 				if fflib.SimpleLetterEqualFold(ffjKeyBalanceBalance, kn) {
 					currentKey = ffjtBalanceBalance
 					state = fflib.FFParse_want_colon
@@ -243,6 +277,9 @@ mainparse:
 				case ffjtBalanceBalance:
 					goto handle_Balance
 
+				case ffjtBalanceVestingPolicy:
+					goto handle_VestingPolicy
+
 				case ffjtBalanceLastClaimDate:
 					goto handle_LastClaimDate
 
@@ -308,6 +345,32 @@ handle_Balance:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
+handle_VestingPolicy:
+
+	/* handler: j.VestingPolicy type=types.BalanceVestingPolicy kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.VestingPolicy = nil
+
+		} else {
+
+			if j.VestingPolicy == nil {
+				j.VestingPolicy = new(BalanceVestingPolicy)
+			}
+
+			err = j.VestingPolicy.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
 handle_LastClaimDate:
 
 	/* handler: j.LastClaimDate type=types.Time kind=struct quoted=false*/
@@ -375,3 +438,596 @@ done:
 
 	return nil
 }
+
+// MarshalJSON marshal bytes to json - template
+func (j *BalanceVestingPolicy) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *BalanceVestingPolicy) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"begin_timestamp":`)
+
+	{
+
+		obj, err = j.BeginTimestamp.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		buf.Write(obj)
+
+	}
+	buf.WriteString(`,"vesting_cliff_seconds":`)
+	fflib.FormatBits2(buf, uint64(j.VestingCliffSeconds), 10, false)
+	buf.WriteString(`,"vesting_duration_seconds":`)
+	fflib.FormatBits2(buf, uint64(j.VestingDurationSeconds), 10, false)
+	buf.WriteString(`,"begin_balance":`)
+	fflib.FormatBits2(buf, uint64(j.BeginBalance), 10, j.BeginBalance < 0)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtBalanceVestingPolicybase = iota
+	ffjtBalanceVestingPolicynosuchkey
+
+	ffjtBalanceVestingPolicyBeginTimestamp
+
+	ffjtBalanceVestingPolicyVestingCliffSeconds
+
+	ffjtBalanceVestingPolicyVestingDurationSeconds
+
+	ffjtBalanceVestingPolicyBeginBalance
+)
+
+var ffjKeyBalanceVestingPolicyBeginTimestamp = []byte("begin_timestamp")
+
+var ffjKeyBalanceVestingPolicyVestingCliffSeconds = []byte("vesting_cliff_seconds")
+
+var ffjKeyBalanceVestingPolicyVestingDurationSeconds = []byte("vesting_duration_seconds")
+
+var ffjKeyBalanceVestingPolicyBeginBalance = []byte("begin_balance")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *BalanceVestingPolicy) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *BalanceVestingPolicy) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtBalanceVestingPolicybase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtBalanceVestingPolicynosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'b':
+
+					if bytes.Equal(ffjKeyBalanceVestingPolicyBeginTimestamp, kn) {
+						currentKey = ffjtBalanceVestingPolicyBeginTimestamp
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyBalanceVestingPolicyBeginBalance, kn) {
+						currentKey = ffjtBalanceVestingPolicyBeginBalance
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'v':
+
+					if bytes.Equal(ffjKeyBalanceVestingPolicyVestingCliffSeconds, kn) {
+						currentKey = ffjtBalanceVestingPolicyVestingCliffSeconds
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyBalanceVestingPolicyVestingDurationSeconds, kn) {
+						currentKey = ffjtBalanceVestingPolicyVestingDurationSeconds
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.AsciiEqualFold(ffjKeyBalanceVestingPolicyBeginBalance, kn) {
+					currentKey = ffjtBalanceVestingPolicyBeginBalance
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyBalanceVestingPolicyVestingDurationSeconds, kn) {
+					currentKey = ffjtBalanceVestingPolicyVestingDurationSeconds
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyBalanceVestingPolicyVestingCliffSeconds, kn) {
+					currentKey = ffjtBalanceVestingPolicyVestingCliffSeconds
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyBalanceVestingPolicyBeginTimestamp, kn) {
+					currentKey = ffjtBalanceVestingPolicyBeginTimestamp
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtBalanceVestingPolicynosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtBalanceVestingPolicyBeginTimestamp:
+					goto handle_BeginTimestamp
+
+				case ffjtBalanceVestingPolicyVestingCliffSeconds:
+					goto handle_VestingCliffSeconds
+
+				case ffjtBalanceVestingPolicyVestingDurationSeconds:
+					goto handle_VestingDurationSeconds
+
+				case ffjtBalanceVestingPolicyBeginBalance:
+					goto handle_BeginBalance
+
+				case ffjtBalanceVestingPolicynosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_BeginTimestamp:
+
+	/* handler: j.BeginTimestamp type=types.Time kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.BeginTimestamp.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_VestingCliffSeconds:
+
+	/* handler: j.VestingCliffSeconds type=types.UInt32 kind=uint32 quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.VestingCliffSeconds.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_VestingDurationSeconds:
+
+	/* handler: j.VestingDurationSeconds type=types.UInt32 kind=uint32 quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.VestingDurationSeconds.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_BeginBalance:
+
+	/* handler: j.BeginBalance type=types.Int64 kind=int64 quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.BeginBalance.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *ClaimableBalance) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *ClaimableBalance) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"Balance":`)
+
+	{
+
+		err = j.Balance.MarshalJSONBuf(buf)
+		if err != nil {
+			return err
+		}
+
+	}
+	buf.WriteString(`,"Key":`)
+
+	{
+
+		obj, err = j.Key.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		buf.Write(obj)
+
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtClaimableBalancebase = iota
+	ffjtClaimableBalancenosuchkey
+
+	ffjtClaimableBalanceBalance
+
+	ffjtClaimableBalanceKey
+)
+
+var ffjKeyClaimableBalanceBalance = []byte("Balance")
+
+var ffjKeyClaimableBalanceKey = []byte("Key")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *ClaimableBalance) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *ClaimableBalance) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtClaimableBalancebase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtClaimableBalancenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'B':
+
+					if bytes.Equal(ffjKeyClaimableBalanceBalance, kn) {
+						currentKey = ffjtClaimableBalanceBalance
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'K':
+
+					if bytes.Equal(ffjKeyClaimableBalanceKey, kn) {
+						currentKey = ffjtClaimableBalanceKey
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.EqualFoldRight(ffjKeyClaimableBalanceKey, kn) {
+					currentKey = ffjtClaimableBalanceKey
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyClaimableBalanceBalance, kn) {
+					currentKey = ffjtClaimableBalanceBalance
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtClaimableBalancenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtClaimableBalanceBalance:
+					goto handle_Balance
+
+				case ffjtClaimableBalanceKey:
+					goto handle_Key
+
+				case ffjtClaimableBalancenosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Balance:
+
+	/* handler: j.Balance type=types.Balance kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			err = j.Balance.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Key:
+
+	/* handler: j.Key type=types.PublicKey kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.Key.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/denkhaus/bitshares/config"
+	"github.com/pquerna/ffjson/ffjson"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBalanceAvailable(t *testing.T) {
+	config.SetCurrent(config.ChainIDBTS)
+
+	var bal Balance
+	data := []byte(`{
+		"id": "1.15.0",
+		"owner": "BTSFAbAx7yuxt725qSZvfwWqkdCwp9ZnUama",
+		"balance": {"amount": 6000, "asset_id": "1.3.0"},
+		"vesting_policy": {
+			"begin_timestamp": "2015-10-14T00:00:00",
+			"vesting_cliff_seconds": 0,
+			"vesting_duration_seconds": 1000,
+			"begin_balance": 10000
+		},
+		"last_claim_date": "2015-10-14T00:00:00"
+	}`)
+
+	if !assert.NoError(t, ffjson.Unmarshal(data, &bal)) || !assert.NotNil(t, bal.VestingPolicy) {
+		return
+	}
+
+	begin := bal.VestingPolicy.BeginTimestamp.Time
+	assert.Equal(t, Int64(10000), bal.VestingPolicy.BeginBalance)
+
+	//4000 were withdrawn already, nothing left before 40% vested
+	assert.Equal(t, Int64(0), bal.Available(begin).Amount)
+	assert.Equal(t, Int64(0), bal.Available(begin.Add(400*time.Second)).Amount)
+	assert.Equal(t, Int64(1000), bal.Available(begin.Add(500*time.Second)).Amount)
+	assert.Equal(t, Int64(6000), bal.Available(begin.Add(2000*time.Second)).Amount)
+	assert.Equal(t, "1.3.0", bal.Available(begin).Asset.String())
+
+	//vesting balances are claimable once a day
+	assert.False(t, bal.Claimable(begin.Add(500*time.Second)))
+	assert.True(t, bal.Claimable(begin.Add(BalanceClaimInterval)))
+
+	bal.VestingPolicy.VestingCliffSeconds = 900
+	assert.Equal(t, Int64(0), bal.Available(begin.Add(800*time.Second)).Amount)
+
+	bal.VestingPolicy = nil
+	assert.Equal(t, Int64(6000), bal.Available(begin).Amount)
+	assert.True(t, bal.Claimable(begin))
+}
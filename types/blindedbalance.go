@@ -0,0 +1,13 @@
+package types
+
+//go:generate ffjson $GOFILE
+
+//BlindedBalance is a commitment to a blinded amount of an asset, spendable by its owner.
+type BlindedBalance struct {
+	ID         BlindedBalanceID `json:"id"`
+	Commitment FixedBuffer      `json:"commitment"`
+	AssetID    AssetID          `json:"asset_id"`
+	Owner      Authority        `json:"owner"`
+}
+
+type BlindedBalances []BlindedBalance
@@ -3,10 +3,19 @@ package types
 //go:generate ffjson $GOFILE
 
 import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcutil/base58"
 	"github.com/denkhaus/bitshares/util"
 	"github.com/juju/errors"
 )
 
+var (
+	ErrInvalidStealthConfirmation = errors.New("invalid stealth confirmation")
+)
+
 type StealthConfirmation struct {
 
 	//    struct memo_data
@@ -40,6 +49,61 @@ func (p StealthConfirmation) Marshal(enc *util.TypeEncoder) error {
 	return nil
 }
 
+//String returns the base58 encoded confirmation receipt the cli_wallet exchanges
+//between sender and recipient of a blind transfer.
+func (p StealthConfirmation) String() string {
+	var b bytes.Buffer
+	if err := util.NewTypeEncoder(&b).Encode(p); err != nil {
+		return ""
+	}
+
+	return base58.Encode(b.Bytes())
+}
+
+//NewStealthConfirmationFromString decodes a base58 confirmation receipt.
+func NewStealthConfirmationFromString(receipt string) (*StealthConfirmation, error) {
+	data := base58.Decode(receipt)
+	if len(data) < btcec.PubKeyBytesLenCompressed+2 {
+		return nil, ErrInvalidStealthConfirmation
+	}
+
+	parseKey := func(buf []byte) (*PublicKey, error) {
+		key, err := btcec.ParsePubKey(buf, btcec.S256())
+		if err != nil {
+			return nil, errors.Annotate(err, "ParsePubKey")
+		}
+
+		return NewPublicKey(key)
+	}
+
+	oneTimeKey, err := parseKey(data[:btcec.PubKeyBytesLenCompressed])
+	if err != nil {
+		return nil, errors.Annotate(err, "parse OneTimeKey")
+	}
+
+	conf := StealthConfirmation{OneTimeKey: *oneTimeKey}
+	hasTo, data := data[btcec.PubKeyBytesLenCompressed], data[btcec.PubKeyBytesLenCompressed+1:]
+	if hasTo == 1 {
+		if len(data) < btcec.PubKeyBytesLenCompressed+1 {
+			return nil, ErrInvalidStealthConfirmation
+		}
+
+		if conf.To, err = parseKey(data[:btcec.PubKeyBytesLenCompressed]); err != nil {
+			return nil, errors.Annotate(err, "parse To")
+		}
+
+		data = data[btcec.PubKeyBytesLenCompressed:]
+	}
+
+	length, n := binary.Uvarint(data)
+	if n <= 0 || uint64(len(data)-n) != length {
+		return nil, ErrInvalidStealthConfirmation
+	}
+
+	conf.EncryptedMemo = Buffer(data[n:])
+	return &conf, nil
+}
+
 type BlindOutputs []BlindOutput
 
 func (p BlindOutputs) Marshal(enc *util.TypeEncoder) error {
@@ -14,6 +14,8 @@ type CommitteeMember struct {
 	VoteID                 VoteID            `json:"vote_id"`
 }
 
+type CommitteeMembers []CommitteeMember
+
 func (p CommitteeMember) Marshal(enc *util.TypeEncoder) error {
 	if err := enc.Encode(p.CommitteeMemberAccount); err != nil {
 		return errors.Annotate(err, "encode CommitteeMemberAccount")
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/juju/errors"
+	"github.com/pquerna/ffjson/ffjson"
+)
+
+type FillOrderHistories []FillOrderHistory
+
+//FillOrderHistoryKey identifies a FillOrderHistory entry within its market.
+type FillOrderHistoryKey struct {
+	Base     AssetID `json:"base"`
+	Quote    AssetID `json:"quote"`
+	Sequence Int64   `json:"sequence"`
+}
+
+//FillOrderHistory is an order history object of the market_history plugin.
+//Op holds the FillOrderOperation that was emitted by the chain.
+type FillOrderHistory struct {
+	ID   ObjectID            `json:"id"`
+	Key  FillOrderHistoryKey `json:"key"`
+	Time Time                `json:"time"`
+	Op   Operation           `json:"op"`
+}
+
+func (p *FillOrderHistory) UnmarshalJSON(data []byte) error {
+	raw := struct {
+		ID   ObjectID            `json:"id"`
+		Key  FillOrderHistoryKey `json:"key"`
+		Time Time                `json:"time"`
+		Op   json.RawMessage     `json:"op"`
+	}{}
+
+	if err := ffjson.Unmarshal(data, &raw); err != nil {
+		return errors.Annotate(err, "unmarshal [raw]")
+	}
+
+	getOp, ok := OperationMap[OperationTypeFillOrder]
+	if !ok {
+		return errors.Errorf("Operation type %s not yet supported", OperationTypeFillOrder)
+	}
+
+	op := getOp()
+	if err := ffjson.Unmarshal(raw.Op, op); err != nil {
+		return errors.Annotate(err, "unmarshal [op]")
+	}
+
+	p.ID = raw.ID
+	p.Key = raw.Key
+	p.Time = raw.Time
+	p.Op = op
+	return nil
+}
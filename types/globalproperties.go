@@ -0,0 +1,11 @@
+package types
+
+//go:generate ffjson $GOFILE
+
+type GlobalProperties struct {
+	ID                     GlobalPropertyID   `json:"id"`
+	Parameters             ChainParameters    `json:"parameters"`
+	NextAvailableVoteID    UInt32             `json:"next_available_vote_id"`
+	ActiveCommitteeMembers CommitteeMemberIDs `json:"active_committee_members"`
+	ActiveWitnesses        WitnessIDs         `json:"active_witnesses"`
+}
@@ -0,0 +1,131 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/denkhaus/bitshares/util"
+	"github.com/juju/errors"
+)
+
+const (
+	LegacyAddressVersionBTC byte = 0
+	LegacyAddressVersionPTS byte = 56
+)
+
+//A LegacyAddress is a PTS/BTC style address, version + ripemd160(sha256(pubkey)) + checksum.
+//Balances imported by the genesis are owned by the graphene form of such addresses.
+type LegacyAddress struct {
+	prefix string
+	data   []byte
+}
+
+//NewLegacyAddress derives the legacy address of pub in compressed or uncompressed form.
+func NewLegacyAddress(pub *PublicKey, compressed bool, version byte) (*LegacyAddress, error) {
+	if pub.key == nil {
+		return nil, ErrInvalidPublicKey
+	}
+
+	ser := pub.key.SerializeUncompressed()
+	if compressed {
+		ser = pub.key.SerializeCompressed()
+	}
+
+	sha := sha256.Sum256(ser)
+	hash, err := util.Ripemd160(sha[:])
+	if err != nil {
+		return nil, errors.Annotate(err, "Ripemd160")
+	}
+
+	data := append([]byte{version}, hash...)
+	chk1 := sha256.Sum256(data)
+	chk2 := sha256.Sum256(chk1[:])
+
+	return &LegacyAddress{
+		prefix: pub.prefix,
+		data:   append(data, chk2[:4]...),
+	}, nil
+}
+
+//NewLegacyAddresses derives the legacy addresses graphene accepts for balance claims by pub,
+//uncompressed and compressed with PTS and BTC versions.
+func NewLegacyAddresses(pub *PublicKey) ([]LegacyAddress, error) {
+	ret := make([]LegacyAddress, 0, 4)
+	for _, version := range []byte{LegacyAddressVersionPTS, LegacyAddressVersionBTC} {
+		for _, compressed := range []bool{false, true} {
+			addr, err := NewLegacyAddress(pub, compressed, version)
+			if err != nil {
+				return nil, errors.Annotate(err, "NewLegacyAddress")
+			}
+
+			ret = append(ret, *addr)
+		}
+	}
+
+	return ret, nil
+}
+
+//Version returns the version byte of the address.
+func (p LegacyAddress) Version() byte {
+	return p.data[0]
+}
+
+//Bytes returns version, hash and checksum.
+func (p LegacyAddress) Bytes() []byte {
+	return p.data
+}
+
+func (p LegacyAddress) String() string {
+	return base58.Encode(p.data)
+}
+
+//ToAddress converts the address to graphene's ripemd160 form.
+func (p LegacyAddress) ToAddress() (*Address, error) {
+	data, err := util.Ripemd160(p.data)
+	if err != nil {
+		return nil, errors.Annotate(err, "Ripemd160")
+	}
+
+	chk, err := util.Ripemd160Checksum(data)
+	if err != nil {
+		return nil, errors.Annotate(err, "Ripemd160Checksum")
+	}
+
+	return &Address{
+		prefix:   p.prefix,
+		data:     data,
+		checksum: chk,
+	}, nil
+}
+
+//BalanceAddresses returns all addresses a balance claimable by pub can be owned by,
+//the graphene address followed by the converted legacy addresses.
+func (p *PublicKey) BalanceAddresses() ([]Address, error) {
+	addr, err := NewAddress(p)
+	if err != nil {
+		return nil, errors.Annotate(err, "NewAddress")
+	}
+
+	legacy, err := NewLegacyAddresses(p)
+	if err != nil {
+		return nil, errors.Annotate(err, "NewLegacyAddresses")
+	}
+
+	ret := []Address{*addr}
+	for _, l := range legacy {
+		conv, err := l.ToAddress()
+		if err != nil {
+			return nil, errors.Annotate(err, "ToAddress")
+		}
+
+		ret = append(ret, *conv)
+	}
+
+	return ret, nil
+}
+
+//Equal returns true if both addresses hash the same key.
+func (p Address) Equal(addr *Address) bool {
+	return bytes.Equal(p.data, addr.data)
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/denkhaus/bitshares/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLegacyAddress(t *testing.T) {
+	config.SetCurrent(config.ChainIDBTS)
+
+	priv, err := GeneratePrivateKey()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	pub := priv.PublicKey()
+	for _, compressed := range []bool{false, true} {
+		addr, err := NewLegacyAddress(pub, compressed, LegacyAddressVersionBTC)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		ser := pub.key.SerializeUncompressed()
+		if compressed {
+			ser = pub.key.SerializeCompressed()
+		}
+
+		btc, err := btcutil.NewAddressPubKeyHash(btcutil.Hash160(ser), &chaincfg.MainNetParams)
+		if assert.NoError(t, err) {
+			assert.Equal(t, btc.EncodeAddress(), addr.String())
+		}
+	}
+
+	addrs, err := pub.BalanceAddresses()
+	if assert.NoError(t, err) && assert.Len(t, addrs, 5) {
+		graphene, err := NewAddress(pub)
+		if assert.NoError(t, err) {
+			assert.True(t, addrs[0].Equal(graphene))
+		}
+
+		for idx := 1; idx < len(addrs); idx++ {
+			assert.False(t, addrs[idx].Equal(graphene))
+
+			parsed, err := NewAddressFromString(addrs[idx].String())
+			if assert.NoError(t, err) {
+				assert.True(t, parsed.Equal(&addrs[idx]))
+			}
+		}
+	}
+
+	pts, err := NewLegacyAddress(pub, true, LegacyAddressVersionPTS)
+	if assert.NoError(t, err) {
+		assert.Equal(t, LegacyAddressVersionPTS, pts.Version())
+		assert.Equal(t, "P", pts.String()[:1])
+	}
+}
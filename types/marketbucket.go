@@ -0,0 +1,30 @@
+package types
+
+//go:generate ffjson $GOFILE
+
+type MarketBuckets []MarketBucket
+
+//MarketBucketKey identifies a MarketBucket by market, bucket size and open time.
+type MarketBucketKey struct {
+	Base    AssetID `json:"base"`
+	Quote   AssetID `json:"quote"`
+	Seconds UInt32  `json:"seconds"`
+	Open    Time    `json:"open"`
+}
+
+//MarketBucket is an OHLCV bucket maintained by the market_history plugin.
+//All values are raw integer amounts of the keys base and quote asset.
+type MarketBucket struct {
+	ID          ObjectID        `json:"id"`
+	Key         MarketBucketKey `json:"key"`
+	HighBase    Int64           `json:"high_base"`
+	HighQuote   Int64           `json:"high_quote"`
+	LowBase     Int64           `json:"low_base"`
+	LowQuote    Int64           `json:"low_quote"`
+	OpenBase    Int64           `json:"open_base"`
+	OpenQuote   Int64           `json:"open_quote"`
+	CloseBase   Int64           `json:"close_base"`
+	CloseQuote  Int64           `json:"close_quote"`
+	BaseVolume  Int64           `json:"base_volume"`
+	QuoteVolume Int64           `json:"quote_volume"`
+}
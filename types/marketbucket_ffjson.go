@@ -0,0 +1,1095 @@
+// Code generated by ffjson <https://github.com/pquerna/ffjson>. DO NOT EDIT.
+// source: marketbucket.go
+
+package types
+
+import (
+	"bytes"
+	"fmt"
+	fflib "github.com/pquerna/ffjson/fflib/v1"
+)
+
+// MarshalJSON marshal bytes to json - template
+func (j *MarketBucket) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *MarketBucket) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"id":`)
+
+	{
+
+		obj, err = j.ID.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		buf.Write(obj)
+
+	}
+	buf.WriteString(`,"key":`)
+
+	{
+
+		err = j.Key.MarshalJSONBuf(buf)
+		if err != nil {
+			return err
+		}
+
+	}
+	buf.WriteString(`,"high_base":`)
+	fflib.FormatBits2(buf, uint64(j.HighBase), 10, j.HighBase < 0)
+	buf.WriteString(`,"high_quote":`)
+	fflib.FormatBits2(buf, uint64(j.HighQuote), 10, j.HighQuote < 0)
+	buf.WriteString(`,"low_base":`)
+	fflib.FormatBits2(buf, uint64(j.LowBase), 10, j.LowBase < 0)
+	buf.WriteString(`,"low_quote":`)
+	fflib.FormatBits2(buf, uint64(j.LowQuote), 10, j.LowQuote < 0)
+	buf.WriteString(`,"open_base":`)
+	fflib.FormatBits2(buf, uint64(j.OpenBase), 10, j.OpenBase < 0)
+	buf.WriteString(`,"open_quote":`)
+	fflib.FormatBits2(buf, uint64(j.OpenQuote), 10, j.OpenQuote < 0)
+	buf.WriteString(`,"close_base":`)
+	fflib.FormatBits2(buf, uint64(j.CloseBase), 10, j.CloseBase < 0)
+	buf.WriteString(`,"close_quote":`)
+	fflib.FormatBits2(buf, uint64(j.CloseQuote), 10, j.CloseQuote < 0)
+	buf.WriteString(`,"base_volume":`)
+	fflib.FormatBits2(buf, uint64(j.BaseVolume), 10, j.BaseVolume < 0)
+	buf.WriteString(`,"quote_volume":`)
+	fflib.FormatBits2(buf, uint64(j.QuoteVolume), 10, j.QuoteVolume < 0)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtMarketBucketbase = iota
+	ffjtMarketBucketnosuchkey
+
+	ffjtMarketBucketID
+
+	ffjtMarketBucketKey
+
+	ffjtMarketBucketHighBase
+
+	ffjtMarketBucketHighQuote
+
+	ffjtMarketBucketLowBase
+
+	ffjtMarketBucketLowQuote
+
+	ffjtMarketBucketOpenBase
+
+	ffjtMarketBucketOpenQuote
+
+	ffjtMarketBucketCloseBase
+
+	ffjtMarketBucketCloseQuote
+
+	ffjtMarketBucketBaseVolume
+
+	ffjtMarketBucketQuoteVolume
+)
+
+var ffjKeyMarketBucketID = []byte("id")
+
+var ffjKeyMarketBucketKey = []byte("key")
+
+var ffjKeyMarketBucketHighBase = []byte("high_base")
+
+var ffjKeyMarketBucketHighQuote = []byte("high_quote")
+
+var ffjKeyMarketBucketLowBase = []byte("low_base")
+
+var ffjKeyMarketBucketLowQuote = []byte("low_quote")
+
+var ffjKeyMarketBucketOpenBase = []byte("open_base")
+
+var ffjKeyMarketBucketOpenQuote = []byte("open_quote")
+
+var ffjKeyMarketBucketCloseBase = []byte("close_base")
+
+var ffjKeyMarketBucketCloseQuote = []byte("close_quote")
+
+var ffjKeyMarketBucketBaseVolume = []byte("base_volume")
+
+var ffjKeyMarketBucketQuoteVolume = []byte("quote_volume")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *MarketBucket) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *MarketBucket) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtMarketBucketbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtMarketBucketnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'b':
+
+					if bytes.Equal(ffjKeyMarketBucketBaseVolume, kn) {
+						currentKey = ffjtMarketBucketBaseVolume
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'c':
+
+					if bytes.Equal(ffjKeyMarketBucketCloseBase, kn) {
+						currentKey = ffjtMarketBucketCloseBase
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyMarketBucketCloseQuote, kn) {
+						currentKey = ffjtMarketBucketCloseQuote
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'h':
+
+					if bytes.Equal(ffjKeyMarketBucketHighBase, kn) {
+						currentKey = ffjtMarketBucketHighBase
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyMarketBucketHighQuote, kn) {
+						currentKey = ffjtMarketBucketHighQuote
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'i':
+
+					if bytes.Equal(ffjKeyMarketBucketID, kn) {
+						currentKey = ffjtMarketBucketID
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'k':
+
+					if bytes.Equal(ffjKeyMarketBucketKey, kn) {
+						currentKey = ffjtMarketBucketKey
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'l':
+
+					if bytes.Equal(ffjKeyMarketBucketLowBase, kn) {
+						currentKey = ffjtMarketBucketLowBase
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyMarketBucketLowQuote, kn) {
+						currentKey = ffjtMarketBucketLowQuote
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'o':
+
+					if bytes.Equal(ffjKeyMarketBucketOpenBase, kn) {
+						currentKey = ffjtMarketBucketOpenBase
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyMarketBucketOpenQuote, kn) {
+						currentKey = ffjtMarketBucketOpenQuote
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'q':
+
+					if bytes.Equal(ffjKeyMarketBucketQuoteVolume, kn) {
+						currentKey = ffjtMarketBucketQuoteVolume
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.AsciiEqualFold(ffjKeyMarketBucketQuoteVolume, kn) {
+					currentKey = ffjtMarketBucketQuoteVolume
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyMarketBucketBaseVolume, kn) {
+					currentKey = ffjtMarketBucketBaseVolume
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyMarketBucketCloseQuote, kn) {
+					currentKey = ffjtMarketBucketCloseQuote
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyMarketBucketCloseBase, kn) {
+					currentKey = ffjtMarketBucketCloseBase
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeyMarketBucketOpenQuote, kn) {
+					currentKey = ffjtMarketBucketOpenQuote
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyMarketBucketOpenBase, kn) {
+					currentKey = ffjtMarketBucketOpenBase
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeyMarketBucketLowQuote, kn) {
+					currentKey = ffjtMarketBucketLowQuote
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyMarketBucketLowBase, kn) {
+					currentKey = ffjtMarketBucketLowBase
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeyMarketBucketHighQuote, kn) {
+					currentKey = ffjtMarketBucketHighQuote
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyMarketBucketHighBase, kn) {
+					currentKey = ffjtMarketBucketHighBase
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyMarketBucketKey, kn) {
+					currentKey = ffjtMarketBucketKey
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyMarketBucketID, kn) {
+					currentKey = ffjtMarketBucketID
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtMarketBucketnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtMarketBucketID:
+					goto handle_ID
+
+				case ffjtMarketBucketKey:
+					goto handle_Key
+
+				case ffjtMarketBucketHighBase:
+					goto handle_HighBase
+
+				case ffjtMarketBucketHighQuote:
+					goto handle_HighQuote
+
+				case ffjtMarketBucketLowBase:
+					goto handle_LowBase
+
+				case ffjtMarketBucketLowQuote:
+					goto handle_LowQuote
+
+				case ffjtMarketBucketOpenBase:
+					goto handle_OpenBase
+
+				case ffjtMarketBucketOpenQuote:
+					goto handle_OpenQuote
+
+				case ffjtMarketBucketCloseBase:
+					goto handle_CloseBase
+
+				case ffjtMarketBucketCloseQuote:
+					goto handle_CloseQuote
+
+				case ffjtMarketBucketBaseVolume:
+					goto handle_BaseVolume
+
+				case ffjtMarketBucketQuoteVolume:
+					goto handle_QuoteVolume
+
+				case ffjtMarketBucketnosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_ID:
+
+	/* handler: j.ID type=types.ObjectID kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.ID.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Key:
+
+	/* handler: j.Key type=types.MarketBucketKey kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			err = j.Key.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_HighBase:
+
+	/* handler: j.HighBase type=types.Int64 kind=int64 quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.HighBase.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_HighQuote:
+
+	/* handler: j.HighQuote type=types.Int64 kind=int64 quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.HighQuote.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_LowBase:
+
+	/* handler: j.LowBase type=types.Int64 kind=int64 quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.LowBase.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_LowQuote:
+
+	/* handler: j.LowQuote type=types.Int64 kind=int64 quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.LowQuote.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_OpenBase:
+
+	/* handler: j.OpenBase type=types.Int64 kind=int64 quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.OpenBase.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_OpenQuote:
+
+	/* handler: j.OpenQuote type=types.Int64 kind=int64 quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.OpenQuote.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_CloseBase:
+
+	/* handler: j.CloseBase type=types.Int64 kind=int64 quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.CloseBase.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_CloseQuote:
+
+	/* handler: j.CloseQuote type=types.Int64 kind=int64 quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.CloseQuote.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_BaseVolume:
+
+	/* handler: j.BaseVolume type=types.Int64 kind=int64 quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.BaseVolume.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_QuoteVolume:
+
+	/* handler: j.QuoteVolume type=types.Int64 kind=int64 quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.QuoteVolume.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *MarketBucketKey) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *MarketBucketKey) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"base":`)
+
+	{
+
+		obj, err = j.Base.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		buf.Write(obj)
+
+	}
+	buf.WriteString(`,"quote":`)
+
+	{
+
+		obj, err = j.Quote.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		buf.Write(obj)
+
+	}
+	buf.WriteString(`,"seconds":`)
+	fflib.FormatBits2(buf, uint64(j.Seconds), 10, false)
+	buf.WriteString(`,"open":`)
+
+	{
+
+		obj, err = j.Open.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		buf.Write(obj)
+
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtMarketBucketKeybase = iota
+	ffjtMarketBucketKeynosuchkey
+
+	ffjtMarketBucketKeyBase
+
+	ffjtMarketBucketKeyQuote
+
+	ffjtMarketBucketKeySeconds
+
+	ffjtMarketBucketKeyOpen
+)
+
+var ffjKeyMarketBucketKeyBase = []byte("base")
+
+var ffjKeyMarketBucketKeyQuote = []byte("quote")
+
+var ffjKeyMarketBucketKeySeconds = []byte("seconds")
+
+var ffjKeyMarketBucketKeyOpen = []byte("open")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *MarketBucketKey) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *MarketBucketKey) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtMarketBucketKeybase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtMarketBucketKeynosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'b':
+
+					if bytes.Equal(ffjKeyMarketBucketKeyBase, kn) {
+						currentKey = ffjtMarketBucketKeyBase
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'o':
+
+					if bytes.Equal(ffjKeyMarketBucketKeyOpen, kn) {
+						currentKey = ffjtMarketBucketKeyOpen
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'q':
+
+					if bytes.Equal(ffjKeyMarketBucketKeyQuote, kn) {
+						currentKey = ffjtMarketBucketKeyQuote
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeyMarketBucketKeySeconds, kn) {
+						currentKey = ffjtMarketBucketKeySeconds
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyMarketBucketKeyOpen, kn) {
+					currentKey = ffjtMarketBucketKeyOpen
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyMarketBucketKeySeconds, kn) {
+					currentKey = ffjtMarketBucketKeySeconds
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyMarketBucketKeyQuote, kn) {
+					currentKey = ffjtMarketBucketKeyQuote
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyMarketBucketKeyBase, kn) {
+					currentKey = ffjtMarketBucketKeyBase
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtMarketBucketKeynosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtMarketBucketKeyBase:
+					goto handle_Base
+
+				case ffjtMarketBucketKeyQuote:
+					goto handle_Quote
+
+				case ffjtMarketBucketKeySeconds:
+					goto handle_Seconds
+
+				case ffjtMarketBucketKeyOpen:
+					goto handle_Open
+
+				case ffjtMarketBucketKeynosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Base:
+
+	/* handler: j.Base type=types.AssetID kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.Base.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Quote:
+
+	/* handler: j.Quote type=types.AssetID kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.Quote.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Seconds:
+
+	/* handler: j.Seconds type=types.UInt32 kind=uint32 quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.Seconds.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Open:
+
+	/* handler: j.Open type=types.Time kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.Open.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
@@ -50,6 +50,12 @@ func (p *Memo) Encrypt(priv *PrivateKey, msg string) error {
 		return errors.Annotate(err, "SharedSecret")
 	}
 
+	return p.EncryptWithSecret(sec, msg)
+}
+
+//EncryptWithSecret encrypts the given memo message with a shared secret
+//of the senders private key and the recipients public key.
+func (p *Memo) EncryptWithSecret(sec []byte, msg string) error {
 	iv, blk, err := p.cypherBlock(sec)
 	if err != nil {
 		return errors.Annotate(err, "cypherBlock")
@@ -93,18 +99,31 @@ func (p Memo) Decrypt(priv *PrivateKey) (string, error) {
 		return "", errors.Annotate(err, "SharedSecret")
 	}
 
+	return p.DecryptWithSecret(sec)
+}
+
+//DecryptWithSecret decrypts the given memo message with the shared secret of both parties.
+func (p Memo) DecryptWithSecret(sec []byte) (string, error) {
 	iv, blk, err := p.cypherBlock(sec)
 	if err != nil {
 		return "", errors.Annotate(err, "cypherBlock")
 	}
 
+	if len(p.Message) < aes.BlockSize || len(p.Message)%aes.BlockSize != 0 {
+		return "", ErrInvalidChecksum
+	}
+
 	mode := cipher.NewCBCDecrypter(blk, iv)
 	dst := make([]byte, len(p.Message))
 	mode.CryptBlocks(dst, p.Message)
 
 	//verify checksum
 	chk1 := dst[:4]
-	msg := unpad(dst[4:])
+	msg, err := unpad(dst[4:])
+	if err != nil {
+		return "", err
+	}
+
 	dig := sha256.Sum256(msg)
 	chk2 := dig[:4]
 
@@ -159,17 +178,26 @@ func (p Memo) cypherBlock(sec []byte) ([]byte, cipher.Block, error) {
 	return sd[32:48], blk, nil
 }
 
-func unpad(buf []byte) []byte {
-	b := buf[len(buf)-1:][0]
+//unpad strips the PKCS#7 padding of buf. A pad count out of range means
+//the memo was not encrypted for the key in use or is malformed.
+func unpad(buf []byte) ([]byte, error) {
+	if len(buf) == 0 {
+		return nil, ErrInvalidChecksum
+	}
+
+	b := buf[len(buf)-1]
 	cnt := int(b)
-	l := len(buf) - cnt
+	if cnt < 1 || cnt > aes.BlockSize || cnt > len(buf) {
+		return nil, ErrInvalidChecksum
+	}
 
+	l := len(buf) - cnt
 	a := bytes.Repeat([]byte{b}, cnt)
 	if bytes.Compare(a, buf[l:]) == 0 {
-		return buf[:l]
+		return buf[:l], nil
 	}
 
-	return buf
+	return buf, nil
 }
 
 func pad(buf []byte, length int) []byte {
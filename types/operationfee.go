@@ -9,7 +9,12 @@ type OperationFee struct {
 	Fee *AssetAmount `json:"fee,omitempty"`
 }
 
+//GetFee returns the fee of the operation, a zero AssetAmount if no fee is set.
 func (p OperationFee) GetFee() AssetAmount {
+	if p.Fee == nil {
+		return AssetAmount{}
+	}
+
 	return *p.Fee
 }
 
@@ -20,3 +20,9 @@ type OperationRelativeHistory struct {
 	Description String           `json:"description"`
 	Op          OperationHistory `json:"op"`
 }
+
+//OperationHistoryDetail is the result of get_account_history_by_operations.
+type OperationHistoryDetail struct {
+	TotalCount         UInt32             `json:"total_count"`
+	OperationHistories OperationHistories `json:"operation_history_objs"`
+}
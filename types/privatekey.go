@@ -4,8 +4,11 @@ import (
 	"crypto/ecdsa"
 	"encoding/hex"
 	"fmt"
+	"math/big"
+	"unsafe"
 
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcutil"
 	"github.com/btcsuite/btcutil/base58"
 	"github.com/denkhaus/bitshares/util"
@@ -16,14 +19,17 @@ var (
 	ErrInvalidCurve               = fmt.Errorf("invalid elliptic curve")
 	ErrSharedKeyTooBig            = fmt.Errorf("shared key params are too big")
 	ErrSharedKeyIsPointAtInfinity = fmt.Errorf("shared key is point at infinity")
+	ErrInvalidPrivateKeySecret    = fmt.Errorf("invalid private key secret")
+	ErrPrivateKeyNotMarshalable   = fmt.Errorf("private keys are not marshaled to JSON")
 )
 
 type PrivateKeys []PrivateKey
 
 type PrivateKey struct {
-	priv *btcec.PrivateKey
-	pub  *PublicKey
-	raw  []byte
+	priv   *btcec.PrivateKey
+	pub    *PublicKey
+	raw    []byte
+	locked *util.LockedBuffer
 }
 
 func (p PrivateKey) Marshal(enc *util.TypeEncoder) error {
@@ -82,6 +88,125 @@ func NewPrivateKeyFromWif(wifPrivateKey string) (*PrivateKey, error) {
 	return &k, nil
 }
 
+//GeneratePrivateKey creates a new random PrivateKey.
+func GeneratePrivateKey() (*PrivateKey, error) {
+	priv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		return nil, errors.Annotate(err, "NewPrivateKey")
+	}
+
+	return newPrivateKey(priv)
+}
+
+//NewPrivateKeyFromSecret creates a PrivateKey from its 32 byte secret.
+func NewPrivateKeyFromSecret(secret []byte) (*PrivateKey, error) {
+	if len(secret) != btcec.PrivKeyBytesLen {
+		return nil, ErrInvalidPrivateKeySecret
+	}
+
+	d := new(big.Int).SetBytes(secret)
+	if d.Sign() == 0 || d.Cmp(btcec.S256().N) >= 0 {
+		return nil, ErrInvalidPrivateKeySecret
+	}
+
+	priv, _ := btcec.PrivKeyFromBytes(btcec.S256(), secret)
+	return newPrivateKey(priv)
+}
+
+func newPrivateKey(priv *btcec.PrivateKey) (*PrivateKey, error) {
+	// graphene uses uncompressed WIF keys
+	wif, err := btcutil.NewWIF(priv, &chaincfg.MainNetParams, false)
+	if err != nil {
+		return nil, errors.Annotate(err, "NewWIF")
+	}
+
+	return NewPrivateKeyFromWif(wif.String())
+}
+
+//Zero overwrites the secret of p and every copy sharing it. The key is unusable afterwards.
+//Copies of a locked key read zeros after Zero, the locked memory is retired but stays mapped.
+func (p *PrivateKey) Zero() {
+	if p.priv != nil {
+		words := p.priv.D.Bits()
+		for i := range words {
+			words[i] = 0
+		}
+		p.priv.D.SetBits(nil)
+	}
+
+	for i := range p.raw {
+		p.raw[i] = 0
+	}
+
+	if p.locked != nil {
+		p.locked.Destroy()
+		p.locked = nil
+		p.raw = nil
+	}
+}
+
+//Lock moves the secret of p into locked memory, which is excluded from swapping and released by Zero.
+//Keys share locked pages, so holding many keys doesn't exhaust RLIMIT_MEMLOCK.
+func (p *PrivateKey) Lock() error {
+	if p.locked != nil {
+		return nil
+	}
+
+	words := p.priv.D.Bits()
+	wordSize := int(unsafe.Sizeof(big.Word(0)))
+	rawLen := (len(p.raw) + wordSize - 1) / wordSize * wordSize
+
+	buf, err := util.NewLockedBuffer(rawLen + len(words)*wordSize)
+	if err != nil {
+		return errors.Annotate(err, "NewLockedBuffer")
+	}
+
+	data := buf.Bytes()
+	raw := data[:len(p.raw):len(p.raw)]
+	copy(raw, p.raw)
+	for i := range p.raw {
+		p.raw[i] = 0
+	}
+
+	if len(words) > 0 {
+		//the page aligned buffer backs the secret of the key from now on
+		locked := (*[1 << 16]big.Word)(unsafe.Pointer(&data[rawLen]))[:len(words):len(words)]
+		copy(locked, words)
+		for i := range words {
+			words[i] = 0
+		}
+		p.priv.D.SetBits(locked)
+	}
+
+	p.raw = raw
+	p.locked = buf
+	return nil
+}
+
+//Locked returns true if the secret of p is held in locked memory.
+func (p PrivateKey) Locked() bool {
+	return p.locked != nil
+}
+
+//String never reveals the secret, it describes the key by its public key.
+func (p PrivateKey) String() string {
+	if p.pub == nil {
+		return "PrivateKey([redacted])"
+	}
+
+	return fmt.Sprintf("PrivateKey(%s)", p.pub)
+}
+
+//Format prints the redacted String for all verbs, so keys don't leak through fmt or logging.
+func (p PrivateKey) Format(f fmt.State, verb rune) {
+	fmt.Fprint(f, p.String())
+}
+
+//MarshalJSON refuses to encode the key, use ToWIF where export is intended.
+func (p PrivateKey) MarshalJSON() ([]byte, error) {
+	return nil, ErrPrivateKeyNotMarshalable
+}
+
 func (p PrivateKey) PublicKey() *PublicKey {
 	return p.pub
 }
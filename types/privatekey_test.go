@@ -2,6 +2,8 @@ package types
 
 import (
 	"bytes"
+	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/denkhaus/bitshares/config"
@@ -81,3 +83,58 @@ func TestMarshalUnmarshal(t *testing.T) {
 		assert.Equal(t, key2.Bytes(), key1.Bytes())
 	}
 }
+
+func TestPrivateKeyLockAndRedaction(t *testing.T) {
+	config.SetCurrent(config.ChainIDBTS)
+
+	wif, pub := privKeys[0][0], privKeys[0][1]
+	key, err := NewPrivateKeyFromWif(wif)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	hx := key.ToHex()
+	sig, err := key.SignCompact(bytes.Repeat([]byte{1}, 32))
+	assert.NoError(t, err)
+
+	if !assert.NoError(t, key.Lock()) {
+		return
+	}
+
+	assert.True(t, key.Locked())
+	assert.Equal(t, wif, key.ToWIF())
+	assert.Equal(t, hx, key.ToHex())
+
+	locked, err := key.SignCompact(bytes.Repeat([]byte{1}, 32))
+	if assert.NoError(t, err) {
+		assert.Equal(t, sig, locked)
+	}
+
+	for _, out := range []string{key.String(), fmt.Sprintf("%v %+v %#v %s %x", key, key, key, key, *key)} {
+		assert.NotContains(t, out, wif)
+		assert.NotContains(t, out, hx)
+	}
+	assert.Equal(t, "PrivateKey("+pub+")", key.String())
+
+	_, err = json.Marshal(struct{ Key *PrivateKey }{key})
+	assert.Error(t, err)
+
+	//copies read zeros after Zero, the locked memory stays mapped
+	cp := *key
+	key.Zero()
+	assert.False(t, key.Locked())
+	assert.Empty(t, key.ToWIF())
+	assert.Equal(t, 0, key.ECPrivateKey().D.Sign())
+	assert.NotEqual(t, hx, cp.ToHex())
+	assert.Equal(t, 0, cp.ECPrivateKey().D.Sign())
+
+	//a key locked afterwards never shows up in the stale copy
+	other, err := NewPrivateKeyFromWif(privKeys[1][0])
+	if assert.NoError(t, err) && assert.NoError(t, other.Lock()) {
+		assert.Equal(t, privKeys[1][0], other.ToWIF())
+		assert.NotEqual(t, other.ToWIF(), cp.ToWIF())
+		assert.NotEqual(t, other.ToHex(), cp.ToHex())
+		assert.Equal(t, 0, cp.ECPrivateKey().D.Sign())
+		other.Zero()
+	}
+}
@@ -0,0 +1,20 @@
+package types
+
+//go:generate ffjson $GOFILE
+
+//Proposal is a proposed transaction awaiting the approvals required to execute it.
+type Proposal struct {
+	ID                       ProposalID  `json:"id"`
+	ExpirationTime           Time        `json:"expiration_time"`
+	ReviewPeriodTime         *Time       `json:"review_period_time,omitempty"`
+	ProposedTransaction      Transaction `json:"proposed_transaction"`
+	RequiredActiveApprovals  AccountIDs  `json:"required_active_approvals"`
+	AvailableActiveApprovals AccountIDs  `json:"available_active_approvals"`
+	RequiredOwnerApprovals   AccountIDs  `json:"required_owner_approvals"`
+	AvailableOwnerApprovals  AccountIDs  `json:"available_owner_approvals"`
+	AvailableKeyApprovals    PublicKeys  `json:"available_key_approvals"`
+	Proposer                 AccountID   `json:"proposer"`
+	FailReason               String      `json:"fail_reason"`
+}
+
+type Proposals []Proposal
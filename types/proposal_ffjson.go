@@ -0,0 +1,1068 @@
+// Code generated by ffjson <https://github.com/pquerna/ffjson>. DO NOT EDIT.
+// source: proposal.go
+
+package types
+
+import (
+	"bytes"
+	"fmt"
+	fflib "github.com/pquerna/ffjson/fflib/v1"
+)
+
+// MarshalJSON marshal bytes to json - template
+func (j *Proposal) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *Proposal) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"id":`)
+
+	{
+
+		obj, err = j.ID.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		buf.Write(obj)
+
+	}
+	buf.WriteString(`,"expiration_time":`)
+
+	{
+
+		obj, err = j.ExpirationTime.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		buf.Write(obj)
+
+	}
+	buf.WriteByte(',')
+	if j.ReviewPeriodTime != nil {
+		if true {
+			buf.WriteString(`"review_period_time":`)
+
+			{
+
+				obj, err = j.ReviewPeriodTime.MarshalJSON()
+				if err != nil {
+					return err
+				}
+				buf.Write(obj)
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	buf.WriteString(`"proposed_transaction":`)
+
+	{
+
+		err = j.ProposedTransaction.MarshalJSONBuf(buf)
+		if err != nil {
+			return err
+		}
+
+	}
+	buf.WriteString(`,"required_active_approvals":`)
+	if j.RequiredActiveApprovals != nil {
+		buf.WriteString(`[`)
+		for i, v := range j.RequiredActiveApprovals {
+			if i != 0 {
+				buf.WriteString(`,`)
+			}
+
+			{
+
+				obj, err = v.MarshalJSON()
+				if err != nil {
+					return err
+				}
+				buf.Write(obj)
+
+			}
+		}
+		buf.WriteString(`]`)
+	} else {
+		buf.WriteString(`null`)
+	}
+	buf.WriteString(`,"available_active_approvals":`)
+	if j.AvailableActiveApprovals != nil {
+		buf.WriteString(`[`)
+		for i, v := range j.AvailableActiveApprovals {
+			if i != 0 {
+				buf.WriteString(`,`)
+			}
+
+			{
+
+				obj, err = v.MarshalJSON()
+				if err != nil {
+					return err
+				}
+				buf.Write(obj)
+
+			}
+		}
+		buf.WriteString(`]`)
+	} else {
+		buf.WriteString(`null`)
+	}
+	buf.WriteString(`,"required_owner_approvals":`)
+	if j.RequiredOwnerApprovals != nil {
+		buf.WriteString(`[`)
+		for i, v := range j.RequiredOwnerApprovals {
+			if i != 0 {
+				buf.WriteString(`,`)
+			}
+
+			{
+
+				obj, err = v.MarshalJSON()
+				if err != nil {
+					return err
+				}
+				buf.Write(obj)
+
+			}
+		}
+		buf.WriteString(`]`)
+	} else {
+		buf.WriteString(`null`)
+	}
+	buf.WriteString(`,"available_owner_approvals":`)
+	if j.AvailableOwnerApprovals != nil {
+		buf.WriteString(`[`)
+		for i, v := range j.AvailableOwnerApprovals {
+			if i != 0 {
+				buf.WriteString(`,`)
+			}
+
+			{
+
+				obj, err = v.MarshalJSON()
+				if err != nil {
+					return err
+				}
+				buf.Write(obj)
+
+			}
+		}
+		buf.WriteString(`]`)
+	} else {
+		buf.WriteString(`null`)
+	}
+	buf.WriteString(`,"available_key_approvals":`)
+	if j.AvailableKeyApprovals != nil {
+		buf.WriteString(`[`)
+		for i, v := range j.AvailableKeyApprovals {
+			if i != 0 {
+				buf.WriteString(`,`)
+			}
+
+			{
+
+				obj, err = v.MarshalJSON()
+				if err != nil {
+					return err
+				}
+				buf.Write(obj)
+
+			}
+		}
+		buf.WriteString(`]`)
+	} else {
+		buf.WriteString(`null`)
+	}
+	buf.WriteString(`,"proposer":`)
+
+	{
+
+		obj, err = j.Proposer.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		buf.Write(obj)
+
+	}
+	buf.WriteString(`,"fail_reason":`)
+
+	{
+
+		obj, err = j.FailReason.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		buf.Write(obj)
+
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtProposalbase = iota
+	ffjtProposalnosuchkey
+
+	ffjtProposalID
+
+	ffjtProposalExpirationTime
+
+	ffjtProposalReviewPeriodTime
+
+	ffjtProposalProposedTransaction
+
+	ffjtProposalRequiredActiveApprovals
+
+	ffjtProposalAvailableActiveApprovals
+
+	ffjtProposalRequiredOwnerApprovals
+
+	ffjtProposalAvailableOwnerApprovals
+
+	ffjtProposalAvailableKeyApprovals
+
+	ffjtProposalProposer
+
+	ffjtProposalFailReason
+)
+
+var ffjKeyProposalID = []byte("id")
+
+var ffjKeyProposalExpirationTime = []byte("expiration_time")
+
+var ffjKeyProposalReviewPeriodTime = []byte("review_period_time")
+
+var ffjKeyProposalProposedTransaction = []byte("proposed_transaction")
+
+var ffjKeyProposalRequiredActiveApprovals = []byte("required_active_approvals")
+
+var ffjKeyProposalAvailableActiveApprovals = []byte("available_active_approvals")
+
+var ffjKeyProposalRequiredOwnerApprovals = []byte("required_owner_approvals")
+
+var ffjKeyProposalAvailableOwnerApprovals = []byte("available_owner_approvals")
+
+var ffjKeyProposalAvailableKeyApprovals = []byte("available_key_approvals")
+
+var ffjKeyProposalProposer = []byte("proposer")
+
+var ffjKeyProposalFailReason = []byte("fail_reason")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *Proposal) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *Proposal) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtProposalbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtProposalnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'a':
+
+					if bytes.Equal(ffjKeyProposalAvailableActiveApprovals, kn) {
+						currentKey = ffjtProposalAvailableActiveApprovals
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyProposalAvailableOwnerApprovals, kn) {
+						currentKey = ffjtProposalAvailableOwnerApprovals
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyProposalAvailableKeyApprovals, kn) {
+						currentKey = ffjtProposalAvailableKeyApprovals
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'e':
+
+					if bytes.Equal(ffjKeyProposalExpirationTime, kn) {
+						currentKey = ffjtProposalExpirationTime
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'f':
+
+					if bytes.Equal(ffjKeyProposalFailReason, kn) {
+						currentKey = ffjtProposalFailReason
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'i':
+
+					if bytes.Equal(ffjKeyProposalID, kn) {
+						currentKey = ffjtProposalID
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'p':
+
+					if bytes.Equal(ffjKeyProposalProposedTransaction, kn) {
+						currentKey = ffjtProposalProposedTransaction
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyProposalProposer, kn) {
+						currentKey = ffjtProposalProposer
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'r':
+
+					if bytes.Equal(ffjKeyProposalReviewPeriodTime, kn) {
+						currentKey = ffjtProposalReviewPeriodTime
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyProposalRequiredActiveApprovals, kn) {
+						currentKey = ffjtProposalRequiredActiveApprovals
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyProposalRequiredOwnerApprovals, kn) {
+						currentKey = ffjtProposalRequiredOwnerApprovals
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.EqualFoldRight(ffjKeyProposalFailReason, kn) {
+					currentKey = ffjtProposalFailReason
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyProposalProposer, kn) {
+					currentKey = ffjtProposalProposer
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyProposalAvailableKeyApprovals, kn) {
+					currentKey = ffjtProposalAvailableKeyApprovals
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyProposalAvailableOwnerApprovals, kn) {
+					currentKey = ffjtProposalAvailableOwnerApprovals
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyProposalRequiredOwnerApprovals, kn) {
+					currentKey = ffjtProposalRequiredOwnerApprovals
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyProposalAvailableActiveApprovals, kn) {
+					currentKey = ffjtProposalAvailableActiveApprovals
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyProposalRequiredActiveApprovals, kn) {
+					currentKey = ffjtProposalRequiredActiveApprovals
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyProposalProposedTransaction, kn) {
+					currentKey = ffjtProposalProposedTransaction
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeyProposalReviewPeriodTime, kn) {
+					currentKey = ffjtProposalReviewPeriodTime
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeyProposalExpirationTime, kn) {
+					currentKey = ffjtProposalExpirationTime
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyProposalID, kn) {
+					currentKey = ffjtProposalID
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtProposalnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtProposalID:
+					goto handle_ID
+
+				case ffjtProposalExpirationTime:
+					goto handle_ExpirationTime
+
+				case ffjtProposalReviewPeriodTime:
+					goto handle_ReviewPeriodTime
+
+				case ffjtProposalProposedTransaction:
+					goto handle_ProposedTransaction
+
+				case ffjtProposalRequiredActiveApprovals:
+					goto handle_RequiredActiveApprovals
+
+				case ffjtProposalAvailableActiveApprovals:
+					goto handle_AvailableActiveApprovals
+
+				case ffjtProposalRequiredOwnerApprovals:
+					goto handle_RequiredOwnerApprovals
+
+				case ffjtProposalAvailableOwnerApprovals:
+					goto handle_AvailableOwnerApprovals
+
+				case ffjtProposalAvailableKeyApprovals:
+					goto handle_AvailableKeyApprovals
+
+				case ffjtProposalProposer:
+					goto handle_Proposer
+
+				case ffjtProposalFailReason:
+					goto handle_FailReason
+
+				case ffjtProposalnosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_ID:
+
+	/* handler: j.ID type=types.ProposalID kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.ID.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_ExpirationTime:
+
+	/* handler: j.ExpirationTime type=types.Time kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.ExpirationTime.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_ReviewPeriodTime:
+
+	/* handler: j.ReviewPeriodTime type=types.Time kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.ReviewPeriodTime = nil
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			if j.ReviewPeriodTime == nil {
+				j.ReviewPeriodTime = new(Time)
+			}
+
+			err = j.ReviewPeriodTime.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_ProposedTransaction:
+
+	/* handler: j.ProposedTransaction type=types.Transaction kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			err = j.ProposedTransaction.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_RequiredActiveApprovals:
+
+	/* handler: j.RequiredActiveApprovals type=types.AccountIDs kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for AccountIDs", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.RequiredActiveApprovals = nil
+		} else {
+
+			j.RequiredActiveApprovals = []AccountID{}
+
+			wantVal := true
+
+			for {
+
+				var tmpJRequiredActiveApprovals AccountID
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmpJRequiredActiveApprovals type=types.AccountID kind=struct quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						tbuf, err := fs.CaptureField(tok)
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+
+						err = tmpJRequiredActiveApprovals.UnmarshalJSON(tbuf)
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				j.RequiredActiveApprovals = append(j.RequiredActiveApprovals, tmpJRequiredActiveApprovals)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_AvailableActiveApprovals:
+
+	/* handler: j.AvailableActiveApprovals type=types.AccountIDs kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for AccountIDs", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.AvailableActiveApprovals = nil
+		} else {
+
+			j.AvailableActiveApprovals = []AccountID{}
+
+			wantVal := true
+
+			for {
+
+				var tmpJAvailableActiveApprovals AccountID
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmpJAvailableActiveApprovals type=types.AccountID kind=struct quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						tbuf, err := fs.CaptureField(tok)
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+
+						err = tmpJAvailableActiveApprovals.UnmarshalJSON(tbuf)
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				j.AvailableActiveApprovals = append(j.AvailableActiveApprovals, tmpJAvailableActiveApprovals)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_RequiredOwnerApprovals:
+
+	/* handler: j.RequiredOwnerApprovals type=types.AccountIDs kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for AccountIDs", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.RequiredOwnerApprovals = nil
+		} else {
+
+			j.RequiredOwnerApprovals = []AccountID{}
+
+			wantVal := true
+
+			for {
+
+				var tmpJRequiredOwnerApprovals AccountID
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmpJRequiredOwnerApprovals type=types.AccountID kind=struct quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						tbuf, err := fs.CaptureField(tok)
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+
+						err = tmpJRequiredOwnerApprovals.UnmarshalJSON(tbuf)
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				j.RequiredOwnerApprovals = append(j.RequiredOwnerApprovals, tmpJRequiredOwnerApprovals)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_AvailableOwnerApprovals:
+
+	/* handler: j.AvailableOwnerApprovals type=types.AccountIDs kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for AccountIDs", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.AvailableOwnerApprovals = nil
+		} else {
+
+			j.AvailableOwnerApprovals = []AccountID{}
+
+			wantVal := true
+
+			for {
+
+				var tmpJAvailableOwnerApprovals AccountID
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmpJAvailableOwnerApprovals type=types.AccountID kind=struct quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						tbuf, err := fs.CaptureField(tok)
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+
+						err = tmpJAvailableOwnerApprovals.UnmarshalJSON(tbuf)
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				j.AvailableOwnerApprovals = append(j.AvailableOwnerApprovals, tmpJAvailableOwnerApprovals)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_AvailableKeyApprovals:
+
+	/* handler: j.AvailableKeyApprovals type=types.PublicKeys kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for PublicKeys", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.AvailableKeyApprovals = nil
+		} else {
+
+			j.AvailableKeyApprovals = []PublicKey{}
+
+			wantVal := true
+
+			for {
+
+				var tmpJAvailableKeyApprovals PublicKey
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmpJAvailableKeyApprovals type=types.PublicKey kind=struct quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						tbuf, err := fs.CaptureField(tok)
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+
+						err = tmpJAvailableKeyApprovals.UnmarshalJSON(tbuf)
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				j.AvailableKeyApprovals = append(j.AvailableKeyApprovals, tmpJAvailableKeyApprovals)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Proposer:
+
+	/* handler: j.Proposer type=types.AccountID kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.Proposer.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_FailReason:
+
+	/* handler: j.FailReason type=types.String kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.FailReason.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
@@ -502,6 +502,11 @@ type String struct {
 	data string
 }
 
+//NewString creates a String holding s.
+func NewString(s string) String {
+	return String{data: s}
+}
+
 func (p String) MarshalJSON() ([]byte, error) {
 	return ffjson.Marshal(p.data)
 }
@@ -13,6 +13,16 @@ import (
 	"github.com/juju/errors"
 )
 
+//vote types of graphene's vote_id_type
+const (
+	VoteTypeCommittee = 0
+	VoteTypeWitness   = 1
+	VoteTypeWorker    = 2
+)
+
+//ProxyToSelfAccount is GRAPHENE_PROXY_TO_SELF_ACCOUNT, the voting account of accounts voting themselves.
+const ProxyToSelfAccount = "1.2.5"
+
 type Votes []VoteID
 
 //TODO: define this
@@ -70,6 +80,20 @@ func (p *VoteID) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+//Type returns the vote type, one of VoteTypeCommittee, VoteTypeWitness or VoteTypeWorker.
+func (p VoteID) Type() int {
+	return p.typ
+}
+
+//Instance returns the vote instance.
+func (p VoteID) Instance() int {
+	return p.instance
+}
+
+func (p VoteID) String() string {
+	return fmt.Sprintf("%d:%d", p.typ, p.instance)
+}
+
 func (p VoteID) MarshalJSON() ([]byte, error) {
 	return []byte(fmt.Sprintf(`"%d:%d"`, p.typ, p.instance)), nil
 }
@@ -84,9 +108,10 @@ func (p VoteID) Marshal(enc *util.TypeEncoder) error {
 	return nil
 }
 
+//NewVoteID creates a VoteID from its "type:instance" notation.
 func NewVoteID(id string) *VoteID {
 	v := VoteID{}
-	if err := v.UnmarshalJSON([]byte(id)); err != nil {
+	if err := v.UnmarshalJSON([]byte(strconv.Quote(id))); err != nil {
 		panic(errors.Annotatef(err, "unmarshal VoteID from %v", id))
 	}
 
@@ -102,6 +127,11 @@ func voteIDComparator(a, b interface{}) int {
 		return 1
 	case aID.instance < bID.instance:
 		return -1
+	//graphene orders by instance, then type
+	case aID.typ > bID.typ:
+		return 1
+	case aID.typ < bID.typ:
+		return -1
 	default:
 		return 0
 	}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/denkhaus/bitshares/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVoteID(t *testing.T) {
+	vote := NewVoteID("1:25")
+	assert.Equal(t, VoteTypeWitness, vote.Type())
+	assert.Equal(t, 25, vote.Instance())
+	assert.Equal(t, "1:25", vote.String())
+
+	data, err := vote.MarshalJSON()
+	if assert.NoError(t, err) {
+		assert.Equal(t, `"1:25"`, string(data))
+	}
+
+	//votes encode sorted by instance, then type
+	var buf, expected bytes.Buffer
+	votes := Votes{*NewVoteID("2:7"), *NewVoteID("1:7"), *NewVoteID("0:3")}
+	if assert.NoError(t, util.NewTypeEncoder(&buf).Encode(votes)) {
+		enc := util.NewTypeEncoder(&expected)
+		assert.NoError(t, enc.EncodeUVarint(3))
+		for _, id := range []string{"0:3", "1:7", "2:7"} {
+			assert.NoError(t, enc.Encode(*NewVoteID(id)))
+		}
+
+		assert.Equal(t, expected.Bytes(), buf.Bytes())
+	}
+}
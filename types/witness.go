@@ -0,0 +1,19 @@
+package types
+
+//go:generate ffjson $GOFILE
+
+//Witness is a block producer elected by the votes for VoteID.
+type Witness struct {
+	ID                    WitnessID         `json:"id"`
+	WitnessAccount        AccountID         `json:"witness_account"`
+	LastAslot             UInt64            `json:"last_aslot"`
+	SigningKey            PublicKey         `json:"signing_key"`
+	PayVB                 *VestingBalanceID `json:"pay_vb,omitempty"`
+	VoteID                VoteID            `json:"vote_id"`
+	TotalVotes            UInt64            `json:"total_votes"`
+	URL                   String            `json:"url"`
+	TotalMissed           Int64             `json:"total_missed"`
+	LastConfirmedBlockNum UInt32            `json:"last_confirmed_block_num"`
+}
+
+type Witnesses []Witness
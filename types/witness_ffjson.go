@@ -0,0 +1,695 @@
+// Code generated by ffjson <https://github.com/pquerna/ffjson>. DO NOT EDIT.
+// source: witness.go
+
+package types
+
+import (
+	"bytes"
+	"fmt"
+	fflib "github.com/pquerna/ffjson/fflib/v1"
+)
+
+// MarshalJSON marshal bytes to json - template
+func (j *Witness) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *Witness) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"id":`)
+
+	{
+
+		obj, err = j.ID.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		buf.Write(obj)
+
+	}
+	buf.WriteString(`,"witness_account":`)
+
+	{
+
+		obj, err = j.WitnessAccount.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		buf.Write(obj)
+
+	}
+	buf.WriteString(`,"last_aslot":`)
+	fflib.FormatBits2(buf, uint64(j.LastAslot), 10, false)
+	buf.WriteString(`,"signing_key":`)
+
+	{
+
+		obj, err = j.SigningKey.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		buf.Write(obj)
+
+	}
+	buf.WriteByte(',')
+	if j.PayVB != nil {
+		if true {
+			buf.WriteString(`"pay_vb":`)
+
+			{
+
+				obj, err = j.PayVB.MarshalJSON()
+				if err != nil {
+					return err
+				}
+				buf.Write(obj)
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	buf.WriteString(`"vote_id":`)
+
+	{
+
+		obj, err = j.VoteID.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		buf.Write(obj)
+
+	}
+	buf.WriteString(`,"total_votes":`)
+	fflib.FormatBits2(buf, uint64(j.TotalVotes), 10, false)
+	buf.WriteString(`,"url":`)
+
+	{
+
+		obj, err = j.URL.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		buf.Write(obj)
+
+	}
+	buf.WriteString(`,"total_missed":`)
+	fflib.FormatBits2(buf, uint64(j.TotalMissed), 10, j.TotalMissed < 0)
+	buf.WriteString(`,"last_confirmed_block_num":`)
+	fflib.FormatBits2(buf, uint64(j.LastConfirmedBlockNum), 10, false)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtWitnessbase = iota
+	ffjtWitnessnosuchkey
+
+	ffjtWitnessID
+
+	ffjtWitnessWitnessAccount
+
+	ffjtWitnessLastAslot
+
+	ffjtWitnessSigningKey
+
+	ffjtWitnessPayVB
+
+	ffjtWitnessVoteID
+
+	ffjtWitnessTotalVotes
+
+	ffjtWitnessURL
+
+	ffjtWitnessTotalMissed
+
+	ffjtWitnessLastConfirmedBlockNum
+)
+
+var ffjKeyWitnessID = []byte("id")
+
+var ffjKeyWitnessWitnessAccount = []byte("witness_account")
+
+var ffjKeyWitnessLastAslot = []byte("last_aslot")
+
+var ffjKeyWitnessSigningKey = []byte("signing_key")
+
+var ffjKeyWitnessPayVB = []byte("pay_vb")
+
+var ffjKeyWitnessVoteID = []byte("vote_id")
+
+var ffjKeyWitnessTotalVotes = []byte("total_votes")
+
+var ffjKeyWitnessURL = []byte("url")
+
+var ffjKeyWitnessTotalMissed = []byte("total_missed")
+
+var ffjKeyWitnessLastConfirmedBlockNum = []byte("last_confirmed_block_num")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *Witness) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *Witness) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtWitnessbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtWitnessnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'i':
+
+					if bytes.Equal(ffjKeyWitnessID, kn) {
+						currentKey = ffjtWitnessID
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'l':
+
+					if bytes.Equal(ffjKeyWitnessLastAslot, kn) {
+						currentKey = ffjtWitnessLastAslot
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyWitnessLastConfirmedBlockNum, kn) {
+						currentKey = ffjtWitnessLastConfirmedBlockNum
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'p':
+
+					if bytes.Equal(ffjKeyWitnessPayVB, kn) {
+						currentKey = ffjtWitnessPayVB
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeyWitnessSigningKey, kn) {
+						currentKey = ffjtWitnessSigningKey
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffjKeyWitnessTotalVotes, kn) {
+						currentKey = ffjtWitnessTotalVotes
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyWitnessTotalMissed, kn) {
+						currentKey = ffjtWitnessTotalMissed
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'u':
+
+					if bytes.Equal(ffjKeyWitnessURL, kn) {
+						currentKey = ffjtWitnessURL
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'v':
+
+					if bytes.Equal(ffjKeyWitnessVoteID, kn) {
+						currentKey = ffjtWitnessVoteID
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'w':
+
+					if bytes.Equal(ffjKeyWitnessWitnessAccount, kn) {
+						currentKey = ffjtWitnessWitnessAccount
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.EqualFoldRight(ffjKeyWitnessLastConfirmedBlockNum, kn) {
+					currentKey = ffjtWitnessLastConfirmedBlockNum
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyWitnessTotalMissed, kn) {
+					currentKey = ffjtWitnessTotalMissed
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyWitnessURL, kn) {
+					currentKey = ffjtWitnessURL
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyWitnessTotalVotes, kn) {
+					currentKey = ffjtWitnessTotalVotes
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeyWitnessVoteID, kn) {
+					currentKey = ffjtWitnessVoteID
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeyWitnessPayVB, kn) {
+					currentKey = ffjtWitnessPayVB
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyWitnessSigningKey, kn) {
+					currentKey = ffjtWitnessSigningKey
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyWitnessLastAslot, kn) {
+					currentKey = ffjtWitnessLastAslot
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyWitnessWitnessAccount, kn) {
+					currentKey = ffjtWitnessWitnessAccount
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyWitnessID, kn) {
+					currentKey = ffjtWitnessID
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtWitnessnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtWitnessID:
+					goto handle_ID
+
+				case ffjtWitnessWitnessAccount:
+					goto handle_WitnessAccount
+
+				case ffjtWitnessLastAslot:
+					goto handle_LastAslot
+
+				case ffjtWitnessSigningKey:
+					goto handle_SigningKey
+
+				case ffjtWitnessPayVB:
+					goto handle_PayVB
+
+				case ffjtWitnessVoteID:
+					goto handle_VoteID
+
+				case ffjtWitnessTotalVotes:
+					goto handle_TotalVotes
+
+				case ffjtWitnessURL:
+					goto handle_URL
+
+				case ffjtWitnessTotalMissed:
+					goto handle_TotalMissed
+
+				case ffjtWitnessLastConfirmedBlockNum:
+					goto handle_LastConfirmedBlockNum
+
+				case ffjtWitnessnosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_ID:
+
+	/* handler: j.ID type=types.WitnessID kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.ID.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_WitnessAccount:
+
+	/* handler: j.WitnessAccount type=types.AccountID kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.WitnessAccount.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_LastAslot:
+
+	/* handler: j.LastAslot type=types.UInt64 kind=uint64 quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.LastAslot.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SigningKey:
+
+	/* handler: j.SigningKey type=types.PublicKey kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.SigningKey.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_PayVB:
+
+	/* handler: j.PayVB type=types.VestingBalanceID kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.PayVB = nil
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			if j.PayVB == nil {
+				j.PayVB = new(VestingBalanceID)
+			}
+
+			err = j.PayVB.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_VoteID:
+
+	/* handler: j.VoteID type=types.VoteID kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.VoteID.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_TotalVotes:
+
+	/* handler: j.TotalVotes type=types.UInt64 kind=uint64 quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.TotalVotes.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_URL:
+
+	/* handler: j.URL type=types.String kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.URL.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_TotalMissed:
+
+	/* handler: j.TotalMissed type=types.Int64 kind=int64 quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.TotalMissed.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_LastConfirmedBlockNum:
+
+	/* handler: j.LastConfirmedBlockNum type=types.UInt32 kind=uint32 quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			err = j.LastConfirmedBlockNum.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
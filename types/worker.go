@@ -0,0 +1,20 @@
+package types
+
+//go:generate ffjson $GOFILE
+
+//Worker is a budget proposal paid while the votes for VoteFor exceed those for VoteAgainst.
+type Worker struct {
+	ID                WorkerID  `json:"id"`
+	WorkerAccount     AccountID `json:"worker_account"`
+	WorkBeginDate     Time      `json:"work_begin_date"`
+	WorkEndDate       Time      `json:"work_end_date"`
+	DailyPay          Int64     `json:"daily_pay"`
+	VoteFor           VoteID    `json:"vote_for"`
+	VoteAgainst       VoteID    `json:"vote_against"`
+	TotalVotesFor     UInt64    `json:"total_votes_for"`
+	TotalVotesAgainst UInt64    `json:"total_votes_against"`
+	Name              String    `json:"name"`
+	URL               String    `json:"url"`
+}
+
+type Workers []Worker
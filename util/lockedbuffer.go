@@ -0,0 +1,92 @@
+package util
+
+import (
+	"os"
+	"sync"
+
+	"github.com/denkhaus/logging"
+)
+
+//lockedSlotSize is the granularity buffers are carved from locked pages with.
+const lockedSlotSize = 64
+
+//lockedArena hands out slots of shared locked pages, so many small buffers share
+//the RLIMIT_MEMLOCK budget of a single page. Slots are never handed out twice and
+//pages are never unmapped: stale references to a destroyed buffer read zeros
+//instead of faulting or seeing the secret of another owner.
+type lockedArena struct {
+	mu     sync.Mutex
+	chunk  []byte
+	warned bool
+}
+
+var arena lockedArena
+
+func (p *lockedArena) alloc(size int) []byte {
+	n := (size + lockedSlotSize - 1) / lockedSlotSize * lockedSlotSize
+	if n == 0 {
+		n = lockedSlotSize
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.chunk) < n {
+		p.chunk = p.page(n)
+	}
+
+	slot := p.chunk[:n:n]
+	p.chunk = p.chunk[n:]
+	return slot
+}
+
+//page allocates locked pages holding at least size bytes and falls back
+//to unlocked memory with a warning if the platform refuses to lock more.
+func (p *lockedArena) page(size int) []byte {
+	page := os.Getpagesize()
+	size = (size + page - 1) / page * page
+
+	data, err := lockedAlloc(size)
+	if err != nil {
+		if !p.warned {
+			logging.Warnf("locked memory unavailable, key material may be swapped: %s", err)
+			p.warned = true
+		}
+
+		return make([]byte, size)
+	}
+
+	return data
+}
+
+//LockedBuffer is memory for key material that is excluded from swapping where the platform supports it.
+type LockedBuffer struct {
+	data []byte
+	slot []byte
+}
+
+//NewLockedBuffer allocates a zeroed buffer of size bytes from the shared locked arena.
+func NewLockedBuffer(size int) (*LockedBuffer, error) {
+	slot := arena.alloc(size)
+	return &LockedBuffer{data: slot[:size:size], slot: slot}, nil
+}
+
+//Bytes returns the buffer content, valid until Destroy.
+func (p *LockedBuffer) Bytes() []byte {
+	return p.data
+}
+
+//Destroy zeroes the buffer and retires it. The memory stays mapped and is never reused,
+//references still held elsewhere read zeros afterwards.
+func (p *LockedBuffer) Destroy() error {
+	if p.slot == nil {
+		return nil
+	}
+
+	for i := range p.slot {
+		p.slot[i] = 0
+	}
+
+	p.data, p.slot = nil, nil
+	return nil
+}
@@ -0,0 +1,9 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd
+
+package util
+
+//lockedAlloc falls back to heap memory on platforms without mlock.
+func lockedAlloc(size int) ([]byte, error) {
+	return make([]byte, size), nil
+}
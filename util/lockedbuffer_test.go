@@ -0,0 +1,47 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLockedBuffer(t *testing.T) {
+	//far more keys than one page per key would allow under a 64 KiB RLIMIT_MEMLOCK
+	bufs := make([]*LockedBuffer, 0, 1024)
+	for i := 0; i < cap(bufs); i++ {
+		buf, err := NewLockedBuffer(64)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		assert.Len(t, buf.Bytes(), 64)
+		bufs = append(bufs, buf)
+	}
+
+	stale := bufs[0].Bytes()
+	for i := range stale {
+		stale[i] = 0xff
+	}
+
+	for _, buf := range bufs {
+		assert.NoError(t, buf.Destroy())
+	}
+
+	//stale references stay readable and are wiped
+	assert.Equal(t, make([]byte, 64), stale)
+	assert.Nil(t, bufs[0].Bytes())
+	assert.NoError(t, bufs[0].Destroy())
+
+	//destroyed slots are never handed out again
+	buf, err := NewLockedBuffer(64)
+	if assert.NoError(t, err) {
+		assert.Equal(t, make([]byte, 64), buf.Bytes())
+		for i := range buf.Bytes() {
+			buf.Bytes()[i] = 0xaa
+		}
+
+		assert.Equal(t, make([]byte, 64), stale)
+		assert.NoError(t, buf.Destroy())
+	}
+}
@@ -0,0 +1,28 @@
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd
+// +build darwin dragonfly freebsd linux netbsd openbsd
+
+package util
+
+import (
+	"os"
+	"syscall"
+
+	"github.com/juju/errors"
+)
+
+//lockedAlloc maps whole pages outside the Go heap and locks them into memory.
+func lockedAlloc(size int) ([]byte, error) {
+	page := os.Getpagesize()
+	data, err := syscall.Mmap(-1, 0, (size+page-1)/page*page,
+		syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_ANON|syscall.MAP_PRIVATE)
+	if err != nil {
+		return nil, errors.Annotate(err, "Mmap")
+	}
+
+	if err := syscall.Mlock(data); err != nil {
+		syscall.Munmap(data)
+		return nil, errors.Annotate(err, "Mlock")
+	}
+
+	return data, nil
+}
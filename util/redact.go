@@ -0,0 +1,109 @@
+package util
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+
+	"github.com/denkhaus/logging"
+)
+
+const Redacted = "[redacted]"
+
+var (
+	//RedactedFields are the JSON fields whose values never show up in debug dumps.
+	RedactedFields = map[string]bool{
+		"signatures":  true,
+		"signature":   true,
+		"wif":         true,
+		"private_key": true,
+		"priv_key":    true,
+	}
+
+	//RedactedMethods are the API methods whose params never show up in debug dumps.
+	RedactedMethods = map[string]bool{
+		"unlock":       true,
+		"set_password": true,
+		"import_key":   true,
+	}
+
+	wifPattern = regexp.MustCompile(`^(5[HJK][1-9A-HJ-NP-Za-km-z]{49}|[KL][1-9A-HJ-NP-Za-km-z]{51})$`)
+)
+
+//redactedJSON marshals its value with signatures and WIFs removed.
+type redactedJSON struct {
+	v interface{}
+}
+
+func (p redactedJSON) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(p.v)
+	if err != nil {
+		return nil, err
+	}
+
+	return RedactJSON(data), nil
+}
+
+//RedactJSON replaces the values of RedactedFields and all WIF encoded keys in data.
+//Data that is no valid JSON is replaced as a whole.
+func RedactJSON(data []byte) []byte {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		out, _ := json.Marshal(Redacted)
+		return out
+	}
+
+	out, _ := json.Marshal(redact(v))
+	return out
+}
+
+func redact(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		redactParams(v)
+		for key, val := range v {
+			if RedactedFields[key] {
+				v[key] = Redacted
+			} else {
+				v[key] = redact(val)
+			}
+		}
+	case []interface{}:
+		for idx, val := range v {
+			v[idx] = redact(val)
+		}
+	case string:
+		if wifPattern.MatchString(v) {
+			return Redacted
+		}
+	}
+
+	return v
+}
+
+//redactParams removes the params of requests calling one of RedactedMethods,
+//either directly or wrapped as call [api, method, params].
+func redactParams(req map[string]interface{}) {
+	method, _ := req["method"].(string)
+	if RedactedMethods[method] {
+		req["params"] = Redacted
+		return
+	}
+
+	params, ok := req["params"].([]interface{})
+	if !ok || method != "call" || len(params) != 3 {
+		return
+	}
+
+	if method, _ := params[1].(string); RedactedMethods[method] {
+		params[2] = Redacted
+	}
+}
+
+//DDumpJSON dumps in like logging.DDumpJSON with signatures and WIFs redacted.
+func DDumpJSON(descr string, in interface{}) {
+	logging.DDumpJSON(descr, redactedJSON{in})
+}
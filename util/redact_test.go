@@ -0,0 +1,40 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactJSON(t *testing.T) {
+	in := `{"method":"call","params":[0,"get_accounts",["alice","5Hx8KiHLnc3pDLkwe2jujkTTJev72n3Qx7xtyaRNBsJDuejzh9u"]],` +
+		`"signatures":["1f2a"],"ref_block_num":12345,"memo_key":"BTS5zzvbDtkbUVU1gFFsKqCE55U7JbjTp6mTh1usFv7KGgXL7HDQk"}`
+
+	out := string(RedactJSON([]byte(in)))
+	assert.NotContains(t, out, "5Hx8KiHLnc3pDLkwe2jujkTTJev72n3Qx7xtyaRNBsJDuejzh9u")
+	assert.NotContains(t, out, "1f2a")
+	assert.Contains(t, out, `"ref_block_num":12345`)
+	assert.Contains(t, out, "BTS5zzvbDtkbUVU1gFFsKqCE55U7JbjTp6mTh1usFv7KGgXL7HDQk")
+	assert.Contains(t, out, `"alice"`)
+
+	assert.Equal(t, `"`+Redacted+`"`, string(RedactJSON([]byte("no json"))))
+}
+
+func TestRedactJSONMethods(t *testing.T) {
+	for _, in := range []string{
+		`{"method":"unlock","params":["correct horse battery"],"id":1}`,
+		`{"method":"set_password","params":["correct horse battery"],"id":1}`,
+		`{"method":"call","params":[0,"unlock",["correct horse battery"]],"id":1}`,
+	} {
+		out := string(RedactJSON([]byte(in)))
+		assert.NotContains(t, out, "correct horse battery", in)
+		assert.Contains(t, out, `"id":1`, in)
+	}
+
+	out := string(RedactJSON([]byte(`{"method":"import_key","params":["alice","not a wif"]}`)))
+	assert.NotContains(t, out, "not a wif")
+	assert.NotContains(t, out, "alice")
+
+	out = string(RedactJSON([]byte(`{"method":"call","params":[0,"get_accounts",[["1.2.0"]]]}`)))
+	assert.Contains(t, out, "1.2.0")
+}
@@ -6,7 +6,7 @@ import (
 	"github.com/denkhaus/bitshares/api"
 	"github.com/denkhaus/bitshares/config"
 	"github.com/denkhaus/bitshares/types"
-	"github.com/denkhaus/logging"
+	"github.com/denkhaus/bitshares/util"
 	"github.com/juju/errors"
 	"github.com/pquerna/ffjson/ffjson"
 )
@@ -97,7 +97,7 @@ func (p *walletAPI) IsLocked() (bool, error) {
 		return false, err
 	}
 
-	logging.DDumpJSON("is_locked <", resp)
+	util.DDumpJSON("is_locked <", resp)
 
 	var ret bool
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -131,7 +131,7 @@ func (p *walletAPI) Buy(account types.GrapheneObject, base, quote types.Graphene
 		return nil, err
 	}
 
-	logging.DDumpJSON("buy <", resp)
+	util.DDumpJSON("buy <", resp)
 
 	ret := types.SignedTransaction{}
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -166,7 +166,7 @@ func (p *walletAPI) Sell(account types.GrapheneObject, base, quote types.Graphen
 		return nil, err
 	}
 
-	logging.DDumpJSON("sell <", resp)
+	util.DDumpJSON("sell <", resp)
 
 	ret := types.SignedTransaction{}
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -208,7 +208,7 @@ func (p *walletAPI) SellAsset(account types.GrapheneObject, amountToSell string,
 		return nil, err
 	}
 
-	logging.DDumpJSON("sell_asset <", resp)
+	util.DDumpJSON("sell_asset <", resp)
 
 	ret := types.SignedTransaction{}
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -236,7 +236,7 @@ func (p *walletAPI) BorrowAsset(account types.GrapheneObject, amountToBorrow str
 		return nil, err
 	}
 
-	logging.DDumpJSON("borrow_asset <", resp)
+	util.DDumpJSON("borrow_asset <", resp)
 
 	ret := types.SignedTransaction{}
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -252,7 +252,7 @@ func (p *walletAPI) ListAccountBalances(account types.GrapheneObject) (types.Ass
 		return nil, err
 	}
 
-	logging.DDumpJSON("list_account_balances <", resp)
+	util.DDumpJSON("list_account_balances <", resp)
 
 	ret := types.AssetAmounts{}
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -271,7 +271,7 @@ func (p *walletAPI) SerializeTransaction(tx *types.SignedTransaction) (string, e
 		return "", err
 	}
 
-	logging.DDumpJSON("serialize_transaction <", resp)
+	util.DDumpJSON("serialize_transaction <", resp)
 
 	var ret string
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -290,7 +290,7 @@ func (p *walletAPI) SignTransaction(tx *types.SignedTransaction, broadcast bool)
 		return nil, err
 	}
 
-	logging.DDumpJSON("sign_transaction <", resp)
+	util.DDumpJSON("sign_transaction <", resp)
 
 	ret := types.SignedTransaction{}
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -307,7 +307,7 @@ func (p *walletAPI) ReadMemo(memo *types.Memo) (string, error) {
 		return "", err
 	}
 
-	logging.DDumpJSON("read_memo <", resp)
+	util.DDumpJSON("read_memo <", resp)
 
 	var ret string
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -324,7 +324,7 @@ func (p *walletAPI) GetBlock(number uint64) (*types.Block, error) {
 		return nil, err
 	}
 
-	logging.DDumpJSON("get_block <", resp)
+	util.DDumpJSON("get_block <", resp)
 
 	ret := types.Block{}
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -341,7 +341,7 @@ func (p *walletAPI) CancelOrder(orderID types.GrapheneObject, broadcast bool) (*
 		return nil, errors.Annotate(err, "CallAPI")
 	}
 
-	logging.DDumpJSON("cancel_order <", resp)
+	util.DDumpJSON("cancel_order <", resp)
 
 	ret := types.SignedTransaction{}
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -372,7 +372,7 @@ func (p *walletAPI) GetRelativeAccountHistory(account types.GrapheneObject, stop
 		return nil, err
 	}
 
-	logging.DDumpJSON("get_relative_account_history <", resp)
+	util.DDumpJSON("get_relative_account_history <", resp)
 
 	ret := types.OperationRelativeHistories{}
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -396,7 +396,7 @@ func (p *walletAPI) GetDynamicGlobalProperties() (*types.DynamicGlobalProperties
 		return nil, err
 	}
 
-	logging.DDumpJSON("get_dynamic_global_properties <", resp)
+	util.DDumpJSON("get_dynamic_global_properties <", resp)
 
 	var ret types.DynamicGlobalProperties
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -412,7 +412,7 @@ func (p *walletAPI) Info() (*types.Info, error) {
 		return nil, err
 	}
 
-	logging.DDumpJSON("info <", resp)
+	util.DDumpJSON("info <", resp)
 
 	var ret types.Info
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -24,9 +24,13 @@ const (
 	AssetsMaxBatchSize            = 100
 	GetCallOrdersLimit            = 100
 	GetLimitOrdersLimit           = 100
+	GetLimitOrdersMaxDepth        = 300
 	GetForceSettlementOrdersLimit = 100
 	GetTradeHistoryLimit          = 100
 	GetAccountHistoryLimit        = 100
+	GetFillOrderHistoryLimit      = 100
+	LookupAccountsLimit           = 1000
+	LookupVoteAccountsLimit       = 1000
 )
 
 type WebsocketAPI interface {
@@ -37,47 +41,83 @@ type WebsocketAPI interface {
 	DatabaseAPIID() int
 	HistoryAPIID() int
 	BroadcastAPIID() int
+	IsConnected() bool
 	SetCredentials(username, password string)
 	OnError(api.ErrorFunc)
 	Subscribe(apiID int, method string, fn api.SubscribeCallback, args ...interface{}) (*json.RawMessage, error)
-	BuildSignedTransaction(keyBag *crypto.KeyBag, feeAsset types.GrapheneObject, ops ...types.Operation) (*types.SignedTransaction, error)
-	SignTransaction(keyBag *crypto.KeyBag, trx *types.SignedTransaction) error
+	BuildSignedTransaction(signer crypto.Signer, feeAsset types.GrapheneObject, ops ...types.Operation) (*types.SignedTransaction, error)
+	SignTransaction(signer crypto.Signer, trx *types.SignedTransaction) error
+	PrepareOfflineTransaction(expiration time.Duration, feeAsset types.GrapheneObject, ops ...types.Operation) (*crypto.OfflineTransaction, error)
+	BroadcastOfflineTransaction(otx *crypto.OfflineTransaction) error
+	NewTxBuilder() *TxBuilder
 
 	//Websocket API functions
+	ApproveProposal(signer crypto.Signer, feePayingAccount, proposal, feeAsset types.GrapheneObject, approvals ProposalApprovals) error
 	BroadcastTransaction(tx *types.SignedTransaction) error
 	BroadcastTransactionSynchronous(tx *types.SignedTransaction) (*types.BroadcastResponse, error)
+	BuildBalanceClaimTransaction(signer crypto.Signer, depositTo types.GrapheneObject, balances types.ClaimableBalances) (*types.SignedTransaction, error)
 	CancelAllSubscriptions() error
+	CheckProposal(proposal *types.Proposal) (*crypto.AuthorityReport, error)
+	ClaimableBalances(signer crypto.Signer) (types.ClaimableBalances, error)
 	GetAccountBalances(account types.GrapheneObject, assets ...types.GrapheneObject) (types.AssetAmounts, error)
 	GetAccountByName(name string) (*types.Account, error)
 	GetAccountHistory(account types.GrapheneObject, stop types.GrapheneObject, limit int, start types.GrapheneObject) (types.OperationHistories, error)
+	GetAccountHistoryByOperations(account types.GrapheneObject, operationTypes []types.OperationType, start uint32, limit int) (*types.OperationHistoryDetail, error)
+	GetAccountHistoryMemos(signer crypto.Signer, account types.GrapheneObject, stop types.GrapheneObject, limit int, start types.GrapheneObject) (crypto.MemoHistories, error)
+	GetAccountHistoryOperations(account types.GrapheneObject, operationType types.OperationType, start types.GrapheneObject, stop types.GrapheneObject, limit int) (types.OperationHistories, error)
 	GetAccounts(accountIDs ...types.GrapheneObject) (types.Accounts, error)
+	GetBalanceObjects(addrs ...types.Address) (types.Balances, error)
+	GetBlindedBalances(commitments ...types.FixedBuffer) (types.BlindedBalances, error)
+	GetAllWorkers() (types.Workers, error)
 	GetBlock(number uint64) (*types.Block, error)
 	GetBlockHeader(block uint64) (*types.BlockHeader, error)
 	GetCallOrders(assetID types.GrapheneObject, limit int) (types.CallOrders, error)
 	GetChainID() (string, error)
+	GetCommitteeMembers(committeeMemberIDs ...types.GrapheneObject) (types.CommitteeMembers, error)
 	GetDynamicGlobalProperties() (*types.DynamicGlobalProperties, error)
 	GetForceSettlementOrders(assetID types.GrapheneObject, limit int) (types.ForceSettlementOrders, error)
+	GetFillOrderHistory(base, quote types.GrapheneObject, limit int) (types.FillOrderHistories, error)
 	GetFullAccounts(accountIDs ...types.GrapheneObject) (types.FullAccountInfos, error)
+	GetGlobalProperties() (*types.GlobalProperties, error)
 	GetLimitOrders(base, quote types.GrapheneObject, limit int) (types.LimitOrders, error)
 	GetOrderBook(base, quote types.GrapheneObject, depth int) (*types.OrderBook, error)
 	GetMarginPositions(accountID types.GrapheneObject) (types.CallOrders, error)
+	GetMarketHistory(base, quote types.GrapheneObject, bucketSeconds uint32, start, end time.Time) (types.MarketBuckets, error)
+	GetMarketHistoryBuckets() ([]uint32, error)
 	GetObjects(objectIDs ...types.GrapheneObject) ([]interface{}, error)
 	GetPotentialSignatures(tx *types.SignedTransaction) (types.PublicKeys, error)
+	GetProposedTransactions(account types.GrapheneObject) (types.Proposals, error)
 	GetRecentTransactionByID(transactionID uint32) (*types.SignedTransaction, error)
+	GetRelativeAccountHistory(account types.GrapheneObject, stop int64, limit int, start int64) (types.OperationHistories, error)
 	GetRequiredSignatures(tx *types.SignedTransaction, keys types.PublicKeys) (types.PublicKeys, error)
 	GetRequiredFees(ops types.Operations, feeAsset types.GrapheneObject) (types.AssetAmounts, error)
 	GetTicker(base, quote types.GrapheneObject) (*types.MarketTicker, error)
 	GetTradeHistory(base, quote types.GrapheneObject, toTime, fromTime time.Time, limit int) (types.MarketTrades, error)
 	GetTransaction(blockNum uint64, trxInBlock uint32) (*types.SignedTransaction, error)
-	LimitOrderCancel(keyBag *crypto.KeyBag, feePayingAccount, orderID, feeAsset types.GrapheneObject) error
+	GetWitnesses(witnessIDs ...types.GrapheneObject) (types.Witnesses, error)
+	LimitOrderCancel(signer crypto.Signer, feePayingAccount, orderID, feeAsset types.GrapheneObject) error
 	ListAssets(lowerBoundSymbol string, limit int) (types.Assets, error)
+	LookupAccounts(lowerBoundName string, limit int) (types.AccountLookups, error)
 	LookupAssetSymbols(symbols ...string) (types.Assets, error)
+	LookupCommitteeMemberAccounts(lowerBoundName string, limit int) (types.CommitteeMemberLookups, error)
+	LookupVoteIDs(voteIDs ...types.VoteID) ([]interface{}, error)
+	LookupWitnessAccounts(lowerBoundName string, limit int) (types.WitnessLookups, error)
+	RegisterAccount(signer crypto.Signer, registrar, referrer, feeAsset types.GrapheneObject, name string, ownerKey, activeKey, memoKey types.PublicKey, referrerPercent uint16) error
+	RegisterAccountWithPassword(signer crypto.Signer, registrar, referrer, feeAsset types.GrapheneObject, name string, password string, referrerPercent uint16) (*crypto.PasswordKeys, error)
+	RevokeProposalApproval(signer crypto.Signer, feePayingAccount, proposal, feeAsset types.GrapheneObject, approvals ProposalApprovals) error
 	SetSubscribeCallback(ID uint64, clearFilter bool) error
+	SignMessage(signer crypto.Signer, account string, message string) (*crypto.SignedMessage, error)
 	SubscribeToBlockApplied(onBlockApplied api.BlockAppliedCallback) error
 	SubscribeToMarket(base, quote types.GrapheneObject, onMarketData api.SubscribeCallback) error
 	SubscribeToPendingTransactions(onPendingTransaction api.SubscribeCallback) error
-	Transfer(keyBag *crypto.KeyBag, from, to, feeAsset types.GrapheneObject, amount types.AssetAmount, memo string) error
+	Transfer(signer crypto.Signer, from, to, feeAsset types.GrapheneObject, amount types.AssetAmount, memo string) error
+	TransferFromBlind(signer crypto.Signer, to types.GrapheneObject, receipts ...crypto.BlindReceipt) error
+	TransferToBlind(signer crypto.Signer, from, feeAsset types.GrapheneObject, to types.PublicKey, amount types.AssetAmount) (*crypto.BlindReceipt, error)
+	UnspentBlindReceipts(receipts crypto.BlindReceipts) (crypto.BlindReceipts, error)
 	UnsubscribeFromMarket(base, quote types.GrapheneObject) error
+	UpdateAccountAuthorities(signer crypto.Signer, update *crypto.AuthorityUpdate, feeAsset types.GrapheneObject) error
+	UpdateAccountVotes(signer crypto.Signer, update *crypto.VoteUpdate, feeAsset types.GrapheneObject) error
+	VerifyMessage(text string) (*crypto.SignedMessage, error)
 	Get24Volume(base types.GrapheneObject, quote types.GrapheneObject) (*types.Volume24, error)
 }
 
@@ -96,7 +136,7 @@ func (p *websocketAPI) getAPIID(identifier string) (int, error) {
 		return InvalidApiID, errors.Annotatef(err, "CallAPI %s", identifier)
 	}
 
-	logging.DDumpJSON("getApiID <", resp)
+	util.DDumpJSON("getApiID <", resp)
 
 	var id int
 	if err := ffjson.Unmarshal(*resp, &id); err != nil {
@@ -113,7 +153,7 @@ func (p *websocketAPI) login() (bool, error) {
 		return false, errors.Annotate(err, "CallAPI")
 	}
 
-	logging.DDumpJSON("login <", resp)
+	util.DDumpJSON("login <", resp)
 
 	var success bool
 	if err := ffjson.Unmarshal(*resp, &success); err != nil {
@@ -222,22 +262,21 @@ func (p *websocketAPI) BroadcastTransactionSynchronous(tx *types.SignedTransacti
 }
 
 //SignTransaction signs a given transaction.
-//Required signing keys get selected by API and have to be in keyBag.
-func (p *websocketAPI) SignTransaction(keyBag *crypto.KeyBag, tx *types.SignedTransaction) error {
+//Required signing keys get selected by API and have to be available in signer.
+func (p *websocketAPI) SignTransaction(signer crypto.Signer, tx *types.SignedTransaction) error {
 	reqPk, err := p.RequiredSigningKeys(tx)
 	if err != nil {
 		return errors.Annotate(err, "RequiredSigningKeys")
 	}
 
-	signer := crypto.NewTransactionSigner(tx)
-
-	privKeys := keyBag.PrivatesByPublics(reqPk)
-	if len(privKeys) == 0 {
+	pubKeys := crypto.SignerKeys(signer, reqPk)
+	if len(pubKeys) == 0 {
 		return types.ErrNoSigningKeyFound
 	}
 
-	if err := signer.Sign(privKeys, config.Current()); err != nil {
-		return errors.Annotate(err, "Sign")
+	txSigner := crypto.NewTransactionSigner(tx)
+	if err := txSigner.SignWith(signer, pubKeys, config.Current()); err != nil {
+		return errors.Annotate(err, "SignWith")
 	}
 
 	return nil
@@ -245,7 +284,40 @@ func (p *websocketAPI) SignTransaction(keyBag *crypto.KeyBag, tx *types.SignedTr
 
 //BuildSignedTransaction builds a new transaction by given operation(s),
 //applies fees, current block data and signs the transaction.
-func (p *websocketAPI) BuildSignedTransaction(keyBag *crypto.KeyBag, feeAsset types.GrapheneObject, ops ...types.Operation) (*types.SignedTransaction, error) {
+func (p *websocketAPI) BuildSignedTransaction(signer crypto.Signer, feeAsset types.GrapheneObject, ops ...types.Operation) (*types.SignedTransaction, error) {
+	operations := types.Operations(ops)
+	fees, err := p.GetRequiredFees(operations, feeAsset)
+	if err != nil {
+		return nil, errors.Annotate(err, "GetRequiredFees")
+	}
+
+	if err := operations.ApplyFees(fees); err != nil {
+		return nil, errors.Annotate(err, "ApplyFees")
+	}
+
+	props, err := p.GetDynamicGlobalProperties()
+	if err != nil {
+		return nil, errors.Annotate(err, "GetDynamicGlobalProperties")
+	}
+
+	tx, err := types.NewSignedTransactionWithBlockData(props)
+	if err != nil {
+		return nil, errors.Annotate(err, "NewTransaction")
+	}
+
+	tx.Operations = operations
+
+	if err := p.SignTransaction(signer, tx); err != nil {
+		return nil, errors.Annotate(err, "SignTransaction")
+	}
+
+	return tx, nil
+}
+
+//PrepareOfflineTransaction builds a new unsigned transaction by given operation(s),
+//applies fees, current block data and the required signing keys for an offline signer.
+//The transaction expires after expiration, which must leave time for signing.
+func (p *websocketAPI) PrepareOfflineTransaction(expiration time.Duration, feeAsset types.GrapheneObject, ops ...types.Operation) (*crypto.OfflineTransaction, error) {
 	operations := types.Operations(ops)
 	fees, err := p.GetRequiredFees(operations, feeAsset)
 	if err != nil {
@@ -267,24 +339,37 @@ func (p *websocketAPI) BuildSignedTransaction(keyBag *crypto.KeyBag, feeAsset ty
 	}
 
 	tx.Operations = operations
+	tx.Expiration = props.Time.Add(expiration)
 
 	reqPk, err := p.RequiredSigningKeys(tx)
 	if err != nil {
 		return nil, errors.Annotate(err, "RequiredSigningKeys")
 	}
 
-	signer := crypto.NewTransactionSigner(tx)
+	otx, err := crypto.NewOfflineTransaction(tx, config.Current(), reqPk)
+	if err != nil {
+		return nil, errors.Annotate(err, "NewOfflineTransaction")
+	}
+
+	return otx, nil
+}
 
-	privKeys := keyBag.PrivatesByPublics(reqPk)
-	if len(privKeys) == 0 {
-		return nil, types.ErrNoSigningKeyFound
+//BroadcastOfflineTransaction verifies a transaction signed offline and broadcasts it.
+func (p *websocketAPI) BroadcastOfflineTransaction(otx *crypto.OfflineTransaction) error {
+	if otx.ChainID != config.Current().ID {
+		return errors.Annotate(crypto.ErrUnknownChain, otx.ChainID)
 	}
 
-	if err := signer.Sign(privKeys, config.Current()); err != nil {
-		return nil, errors.Annotate(err, "Sign")
+	tx, err := otx.SignedTransaction(time.Now())
+	if err != nil {
+		return errors.Annotate(err, "SignedTransaction")
 	}
 
-	return tx, nil
+	if err := p.BroadcastTransaction(tx); err != nil {
+		return errors.Annotate(err, "BroadcastTransaction")
+	}
+
+	return nil
 }
 
 //RequiredSigningKeys is a convenience call to retrieve the minimum subset of public keys to sign a transaction.
@@ -295,14 +380,14 @@ func (p *websocketAPI) RequiredSigningKeys(tx *types.SignedTransaction) (types.P
 		return nil, errors.Annotate(err, "GetPotentialSignatures")
 	}
 
-	logging.DDumpJSON("potential pubkeys <", potPk)
+	util.DDumpJSON("potential pubkeys <", potPk)
 
 	reqPk, err := p.GetRequiredSignatures(tx, potPk)
 	if err != nil {
 		return nil, errors.Annotate(err, "GetRequiredSignatures")
 	}
 
-	logging.DDumpJSON("required pubkeys <", reqPk)
+	util.DDumpJSON("required pubkeys <", reqPk)
 
 	return reqPk, nil
 }
@@ -316,7 +401,7 @@ func (p *websocketAPI) GetPotentialSignatures(tx *types.SignedTransaction) (type
 		return nil, errors.Annotate(err, "CallAPI")
 	}
 
-	logging.DDumpJSON("get_potential_signatures <", resp)
+	util.DDumpJSON("get_potential_signatures <", resp)
 
 	ret := types.PublicKeys{}
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -333,7 +418,7 @@ func (p *websocketAPI) GetTransaction(blockNum uint64, trxInBlock uint32) (*type
 		return nil, errors.Annotate(err, "CallAPI")
 	}
 
-	logging.DDumpJSON("get_transaction <", resp)
+	util.DDumpJSON("get_transaction <", resp)
 
 	ret := types.SignedTransaction{}
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -353,7 +438,7 @@ func (p *websocketAPI) GetRecentTransactionByID(transactionID uint32) (*types.Si
 		return nil, errors.Annotate(err, "CallAPI")
 	}
 
-	logging.DDumpJSON("get_recent_transaction_by_id <", resp)
+	util.DDumpJSON("get_recent_transaction_by_id <", resp)
 
 	ret := types.SignedTransaction{}
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -370,7 +455,7 @@ func (p *websocketAPI) GetRequiredSignatures(tx *types.SignedTransaction, potKey
 		return nil, errors.Annotate(err, "CallAPI")
 	}
 
-	logging.DDumpJSON("get_required_signatures <", resp)
+	util.DDumpJSON("get_required_signatures <", resp)
 
 	ret := types.PublicKeys{}
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -387,7 +472,7 @@ func (p *websocketAPI) GetBlock(block uint64) (*types.Block, error) {
 		return nil, errors.Annotate(err, "CallAPI")
 	}
 
-	logging.DDumpJSON("get_block <", resp)
+	util.DDumpJSON("get_block <", resp)
 
 	ret := types.Block{}
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -404,7 +489,7 @@ func (p *websocketAPI) GetBlockHeader(block uint64) (*types.BlockHeader, error)
 		return nil, errors.Annotate(err, "CallAPI")
 	}
 
-	logging.DDumpJSON("get_block_header <", resp)
+	util.DDumpJSON("get_block_header <", resp)
 
 	ret := types.BlockHeader{}
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -421,7 +506,7 @@ func (p *websocketAPI) GetTicker(base, quote types.GrapheneObject) (*types.Marke
 		return nil, errors.Annotate(err, "CallAPI")
 	}
 
-	logging.DDumpJSON("get_ticker <", resp)
+	util.DDumpJSON("get_ticker <", resp)
 
 	ret := types.MarketTicker{}
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -438,7 +523,7 @@ func (p *websocketAPI) GetAccountByName(name string) (*types.Account, error) {
 		return nil, errors.Annotate(err, "CallAPI")
 	}
 
-	logging.DDumpJSON("get_account_by_name <", resp)
+	util.DDumpJSON("get_account_by_name <", resp)
 
 	ret := types.Account{}
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -463,7 +548,102 @@ func (p *websocketAPI) GetAccountHistory(account types.GrapheneObject, stop type
 		return nil, errors.Annotate(err, "CallAPI")
 	}
 
-	logging.DDumpJSON("get_account_history <", resp)
+	util.DDumpJSON("get_account_history <", resp)
+
+	ret := types.OperationHistories{}
+	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
+		return nil, errors.Annotate(err, "Unmarshal [Histories]")
+	}
+
+	return ret, nil
+}
+
+//GetAccountHistoryMemos returns account history like GetAccountHistory
+//with memos decrypted by keys held in signer attached.
+func (p *websocketAPI) GetAccountHistoryMemos(signer crypto.Signer, account types.GrapheneObject, stop types.GrapheneObject, limit int, start types.GrapheneObject) (crypto.MemoHistories, error) {
+	hists, err := p.GetAccountHistory(account, stop, limit, start)
+	if err != nil {
+		return nil, errors.Annotate(err, "GetAccountHistory")
+	}
+
+	return crypto.DecryptHistoryMemos(signer, hists), nil
+}
+
+// GetAccountHistoryOperations returns OperationHistory object(s) of a certain OperationType.
+// account: The account whose history should be queried
+// operationType: The type of the operations to retrieve
+// start: ID of the most recent operation to retrieve
+// stop: ID of the earliest operation to retrieve
+// limit: Maximum number of operations to retrieve (must not exceed 100)
+func (p *websocketAPI) GetAccountHistoryOperations(account types.GrapheneObject, operationType types.OperationType,
+	start types.GrapheneObject, stop types.GrapheneObject, limit int) (types.OperationHistories, error) {
+	if limit > GetAccountHistoryLimit {
+		limit = GetAccountHistoryLimit
+	}
+
+	resp, err := p.wsClient.CallAPI(p.historyAPIID, "get_account_history_operations",
+		account.ID(), operationType, start.ID(), stop.ID(), limit,
+	)
+	if err != nil {
+		return nil, errors.Annotate(err, "CallAPI")
+	}
+
+	util.DDumpJSON("get_account_history_operations <", resp)
+
+	ret := types.OperationHistories{}
+	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
+		return nil, errors.Annotate(err, "Unmarshal [Histories]")
+	}
+
+	return ret, nil
+}
+
+// GetAccountHistoryByOperations returns OperationHistory object(s) of the given OperationTypes
+// together with the total count of matching operations.
+// account: The account whose history should be queried
+// operationTypes: The types of the operations to retrieve
+// start: Sequence number of the first operation to query
+// limit: Maximum number of operations to retrieve (must not exceed 100)
+func (p *websocketAPI) GetAccountHistoryByOperations(account types.GrapheneObject, operationTypes []types.OperationType,
+	start uint32, limit int) (*types.OperationHistoryDetail, error) {
+	if limit > GetAccountHistoryLimit {
+		limit = GetAccountHistoryLimit
+	}
+
+	resp, err := p.wsClient.CallAPI(p.historyAPIID, "get_account_history_by_operations",
+		account.ID(), operationTypes, start, limit,
+	)
+	if err != nil {
+		return nil, errors.Annotate(err, "CallAPI")
+	}
+
+	util.DDumpJSON("get_account_history_by_operations <", resp)
+
+	ret := types.OperationHistoryDetail{}
+	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
+		return nil, errors.Annotate(err, "Unmarshal [OperationHistoryDetail]")
+	}
+
+	return &ret, nil
+}
+
+// GetRelativeAccountHistory returns OperationHistory object(s) referenced by
+// an account related sequence number, ordered from most recent to oldest.
+// account: The account whose history should be queried
+// stop: Sequence number of the earliest operation. 0 is default and will query 'limit' number of operations.
+// limit: Maximum number of operations to retrieve (must not exceed 100)
+// start: Sequence number of the most recent operation to retrieve. 0 is default, which will start querying from the most recent operation.
+func (p *websocketAPI) GetRelativeAccountHistory(account types.GrapheneObject, stop int64, limit int, start int64) (types.OperationHistories, error) {
+	if limit > GetAccountHistoryLimit {
+		limit = GetAccountHistoryLimit
+	}
+
+	resp, err := p.wsClient.CallAPI(p.historyAPIID, "get_relative_account_history", account.ID(), stop, limit, start)
+	if err != nil {
+		return nil, errors.Annotate(err, "CallAPI")
+	}
+
+	util.DDumpJSON("get_relative_account_history <", resp)
 
 	ret := types.OperationHistories{}
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -473,6 +653,67 @@ func (p *websocketAPI) GetAccountHistory(account types.GrapheneObject, stop type
 	return ret, nil
 }
 
+// GetFillOrderHistory returns the most recent fills of the market base:quote.
+// limit: Maximum number of fills to retrieve (must not exceed 100)
+func (p *websocketAPI) GetFillOrderHistory(base, quote types.GrapheneObject, limit int) (types.FillOrderHistories, error) {
+	if limit > GetFillOrderHistoryLimit {
+		limit = GetFillOrderHistoryLimit
+	}
+
+	resp, err := p.wsClient.CallAPI(p.historyAPIID, "get_fill_order_history", base.ID(), quote.ID(), limit)
+	if err != nil {
+		return nil, errors.Annotate(err, "CallAPI")
+	}
+
+	util.DDumpJSON("get_fill_order_history <", resp)
+
+	ret := types.FillOrderHistories{}
+	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
+		return nil, errors.Annotate(err, "Unmarshal [FillOrderHistories]")
+	}
+
+	return ret, nil
+}
+
+// GetMarketHistory returns the OHLCV buckets of the market base:quote between start and end.
+// bucketSeconds: The bucket size, must be one of GetMarketHistoryBuckets
+// The node returns at most 200 buckets per call.
+func (p *websocketAPI) GetMarketHistory(base, quote types.GrapheneObject, bucketSeconds uint32, start, end time.Time) (types.MarketBuckets, error) {
+	resp, err := p.wsClient.CallAPI(p.historyAPIID, "get_market_history",
+		base.ID(), quote.ID(), bucketSeconds,
+		types.Time{Time: start.UTC()}, types.Time{Time: end.UTC()},
+	)
+	if err != nil {
+		return nil, errors.Annotate(err, "CallAPI")
+	}
+
+	util.DDumpJSON("get_market_history <", resp)
+
+	ret := types.MarketBuckets{}
+	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
+		return nil, errors.Annotate(err, "Unmarshal [MarketBuckets]")
+	}
+
+	return ret, nil
+}
+
+// GetMarketHistoryBuckets returns the bucket sizes in seconds tracked by the market_history plugin.
+func (p *websocketAPI) GetMarketHistoryBuckets() ([]uint32, error) {
+	resp, err := p.wsClient.CallAPI(p.historyAPIID, "get_market_history_buckets", types.EmptyParams)
+	if err != nil {
+		return nil, errors.Annotate(err, "CallAPI")
+	}
+
+	util.DDumpJSON("get_market_history_buckets <", resp)
+
+	var ret []uint32
+	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
+		return nil, errors.Annotate(err, "Unmarshal [buckets]")
+	}
+
+	return ret, nil
+}
+
 //GetAccounts returns a list of accounts by accountID(s).
 func (p *websocketAPI) GetAccounts(accounts ...types.GrapheneObject) (types.Accounts, error) {
 	ids := types.GrapheneObjects(accounts).ToStrings()
@@ -481,7 +722,7 @@ func (p *websocketAPI) GetAccounts(accounts ...types.GrapheneObject) (types.Acco
 		return nil, errors.Annotate(err, "CallAPI")
 	}
 
-	logging.DDumpJSON("get_accounts <", resp)
+	util.DDumpJSON("get_accounts <", resp)
 
 	ret := types.Accounts{}
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -498,7 +739,7 @@ func (p *websocketAPI) GetDynamicGlobalProperties() (*types.DynamicGlobalPropert
 		return nil, errors.Annotate(err, "CallAPI")
 	}
 
-	logging.DDumpJSON("get_dynamic_global_properties <", resp)
+	util.DDumpJSON("get_dynamic_global_properties <", resp)
 
 	ret := types.DynamicGlobalProperties{}
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -508,6 +749,23 @@ func (p *websocketAPI) GetDynamicGlobalProperties() (*types.DynamicGlobalPropert
 	return &ret, nil
 }
 
+//GetGlobalProperties returns the global properties including the current chain parameters.
+func (p *websocketAPI) GetGlobalProperties() (*types.GlobalProperties, error) {
+	resp, err := p.wsClient.CallAPI(0, "get_global_properties", types.EmptyParams)
+	if err != nil {
+		return nil, errors.Annotate(err, "CallAPI")
+	}
+
+	util.DDumpJSON("get_global_properties <", resp)
+
+	ret := types.GlobalProperties{}
+	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
+		return nil, errors.Annotate(err, "Unmarshal [GlobalProperties]")
+	}
+
+	return &ret, nil
+}
+
 //GetAccountBalances retrieves AssetAmounts by given AccountID
 func (p *websocketAPI) GetAccountBalances(account types.GrapheneObject, assets ...types.GrapheneObject) (types.AssetAmounts, error) {
 	ids := types.GrapheneObjects(assets).ToStrings()
@@ -516,7 +774,7 @@ func (p *websocketAPI) GetAccountBalances(account types.GrapheneObject, assets .
 		return nil, errors.Annotate(err, "CallAPI")
 	}
 
-	logging.DDumpJSON("get_account_balances <", resp)
+	util.DDumpJSON("get_account_balances <", resp)
 
 	ret := types.AssetAmounts{}
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -526,6 +784,164 @@ func (p *websocketAPI) GetAccountBalances(account types.GrapheneObject, assets .
 	return ret, nil
 }
 
+//GetBalanceObjects returns the balance objects owned by addrs, e.g. balances imported by the genesis.
+func (p *websocketAPI) GetBalanceObjects(addrs ...types.Address) (types.Balances, error) {
+	resp, err := p.wsClient.CallAPI(0, "get_balance_objects", addrs)
+	if err != nil {
+		return nil, errors.Annotate(err, "CallAPI")
+	}
+
+	util.DDumpJSON("get_balance_objects <", resp)
+
+	ret := types.Balances{}
+	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
+		return nil, errors.Annotate(err, "Unmarshal [Balances]")
+	}
+
+	return ret, nil
+}
+
+//GetBlindedBalances returns the blinded balances of the given commitments that are still unspent.
+func (p *websocketAPI) GetBlindedBalances(commitments ...types.FixedBuffer) (types.BlindedBalances, error) {
+	resp, err := p.wsClient.CallAPI(0, "get_blinded_balances", commitments)
+	if err != nil {
+		return nil, errors.Annotate(err, "CallAPI")
+	}
+
+	util.DDumpJSON("get_blinded_balances <", resp)
+
+	ret := types.BlindedBalances{}
+	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
+		return nil, errors.Annotate(err, "Unmarshal [BlindedBalances]")
+	}
+
+	return ret, nil
+}
+
+//authorityChecker creates an AuthorityChecker fetching accounts from the node.
+func (p *websocketAPI) authorityChecker() *crypto.AuthorityChecker {
+	return crypto.NewAuthorityChecker(func(id string) (*types.Account, error) {
+		accts, err := p.GetAccounts(types.NewAccountID(id))
+		if err != nil {
+			return nil, errors.Annotate(err, "GetAccounts")
+		}
+
+		//unknown accounts are returned as null
+		if len(accts) == 0 || accts[0].ID.ID() != id {
+			return nil, errors.Annotate(crypto.ErrAccountNotFound, id)
+		}
+
+		return &accts[0], nil
+	})
+}
+
+//GetProposedTransactions returns the pending proposals account is involved in.
+func (p *websocketAPI) GetProposedTransactions(account types.GrapheneObject) (types.Proposals, error) {
+	resp, err := p.wsClient.CallAPI(0, "get_proposed_transactions", account.ID())
+	if err != nil {
+		return nil, errors.Annotate(err, "CallAPI")
+	}
+
+	util.DDumpJSON("get_proposed_transactions <", resp)
+
+	ret := types.Proposals{}
+	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
+		return nil, errors.Annotate(err, "Unmarshal [Proposals]")
+	}
+
+	return ret, nil
+}
+
+//UnspentBlindReceipts returns the receipts whose blinded balances are still on chain.
+func (p *websocketAPI) UnspentBlindReceipts(receipts crypto.BlindReceipts) (crypto.BlindReceipts, error) {
+	commitments := make([]types.FixedBuffer, 0, len(receipts))
+	for _, receipt := range receipts {
+		commitments = append(commitments, receipt.Memo.Commitment)
+	}
+
+	balances, err := p.GetBlindedBalances(commitments...)
+	if err != nil {
+		return nil, errors.Annotate(err, "GetBlindedBalances")
+	}
+
+	ret := crypto.BlindReceipts{}
+	for _, receipt := range receipts {
+		for _, balance := range balances {
+			if balance.Commitment.String() == receipt.Memo.Commitment.String() {
+				ret = append(ret, receipt)
+				break
+			}
+		}
+	}
+
+	return ret, nil
+}
+
+//ClaimableBalances scans the graphene and legacy PTS/BTC addresses of all keys in signer for balance objects.
+func (p *websocketAPI) ClaimableBalances(signer crypto.Signer) (types.ClaimableBalances, error) {
+	ret := types.ClaimableBalances{}
+	for _, pub := range signer.Publics() {
+		addrs, err := pub.BalanceAddresses()
+		if err != nil {
+			return nil, errors.Annotate(err, "BalanceAddresses")
+		}
+
+		balances, err := p.GetBalanceObjects(addrs...)
+		if err != nil {
+			return nil, errors.Annotate(err, "GetBalanceObjects")
+		}
+
+		for _, balance := range balances {
+			ret = append(ret, types.ClaimableBalance{
+				Balance: balance,
+				Key:     pub,
+			})
+		}
+	}
+
+	return ret, nil
+}
+
+//BuildBalanceClaimTransaction builds a transaction claiming balances to depositTo and signs it
+//with the owning keys in signer. Like cli_wallet import_balance, each balance is claimed with the amount
+//available at head block time, balances with nothing claimable right now are skipped.
+func (p *websocketAPI) BuildBalanceClaimTransaction(signer crypto.Signer, depositTo types.GrapheneObject, balances types.ClaimableBalances) (*types.SignedTransaction, error) {
+	if len(balances) == 0 {
+		return nil, errors.New("no balances to claim")
+	}
+
+	props, err := p.GetDynamicGlobalProperties()
+	if err != nil {
+		return nil, errors.Annotate(err, "GetDynamicGlobalProperties")
+	}
+
+	now := props.Time.Time
+	ops := make([]types.Operation, 0, len(balances))
+	for _, claim := range balances {
+		if !claim.Balance.Claimable(now) {
+			continue
+		}
+
+		ops = append(ops, &operations.BalanceClaimOperation{
+			BalanceToClaim:   claim.Balance.ID,
+			BalanceOwnerKey:  claim.Key,
+			DepositToAccount: types.AccountIDFromObject(depositTo),
+			TotalClaimed:     claim.Balance.Available(now),
+		})
+	}
+
+	if len(ops) == 0 {
+		return nil, errors.New("no balance is claimable now")
+	}
+
+	tx, err := p.BuildSignedTransaction(signer, types.NewAssetID("1.3.0"), ops...)
+	if err != nil {
+		return nil, errors.Annotate(err, "BuildSignedTransaction")
+	}
+
+	return tx, nil
+}
+
 // GetFullAccounts retrieves full account information by given AccountIDs
 func (p *websocketAPI) GetFullAccounts(accounts ...types.GrapheneObject) (types.FullAccountInfos, error) {
 	ids := types.GrapheneObjects(accounts).ToStrings()
@@ -534,7 +950,7 @@ func (p *websocketAPI) GetFullAccounts(accounts ...types.GrapheneObject) (types.
 		return nil, errors.Annotate(err, "CallAPI")
 	}
 
-	logging.DDumpJSON("get_full_accounts <", resp)
+	util.DDumpJSON("get_full_accounts <", resp)
 
 	ret := types.FullAccountInfos{}
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -551,7 +967,7 @@ func (p *websocketAPI) Get24Volume(base, quote types.GrapheneObject) (*types.Vol
 		return nil, errors.Annotate(err, "CallAPI")
 	}
 
-	logging.DDumpJSON("get_24_volume <", resp)
+	util.DDumpJSON("get_24_volume <", resp)
 
 	ret := types.Volume24{}
 	if err = ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -574,7 +990,7 @@ func (p *websocketAPI) ListAssets(lowerBoundSymbol string, limit int) (types.Ass
 		return nil, errors.Annotate(err, "CallAPI")
 	}
 
-	logging.DDumpJSON("list_assets <", resp)
+	util.DDumpJSON("list_assets <", resp)
 
 	ret := types.Assets{}
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -584,6 +1000,29 @@ func (p *websocketAPI) ListAssets(lowerBoundSymbol string, limit int) (types.Ass
 	return ret, nil
 }
 
+// LookupAccounts retrieves account names and IDs in alphabetical order.
+// lowerBoundName: Lower bound of the first name to return
+// limit: Maximum number of results to return (must not exceed 1000)
+func (p *websocketAPI) LookupAccounts(lowerBoundName string, limit int) (types.AccountLookups, error) {
+	if limit > LookupAccountsLimit {
+		limit = LookupAccountsLimit
+	}
+
+	resp, err := p.wsClient.CallAPI(0, "lookup_accounts", lowerBoundName, limit)
+	if err != nil {
+		return nil, errors.Annotate(err, "CallAPI")
+	}
+
+	util.DDumpJSON("lookup_accounts <", resp)
+
+	ret := types.AccountLookups{}
+	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
+		return nil, errors.Annotate(err, "Unmarshal [AccountLookups]")
+	}
+
+	return ret, nil
+}
+
 // LookupAssetSymbols get assets corresponding to the provided symbols or IDs
 func (p *websocketAPI) LookupAssetSymbols(symbols ...string) (types.Assets, error) {
 	resp, err := p.wsClient.CallAPI(0, "lookup_asset_symbols", symbols)
@@ -591,7 +1030,7 @@ func (p *websocketAPI) LookupAssetSymbols(symbols ...string) (types.Assets, erro
 		return nil, errors.Annotate(err, "CallAPI")
 	}
 
-	logging.DDumpJSON("lookup_asset_symbols <", resp)
+	util.DDumpJSON("lookup_asset_symbols <", resp)
 
 	ret := types.Assets{}
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -601,6 +1040,155 @@ func (p *websocketAPI) LookupAssetSymbols(symbols ...string) (types.Assets, erro
 	return ret, nil
 }
 
+//GetWitnesses returns the witnesses with the given ids.
+func (p *websocketAPI) GetWitnesses(witnessIDs ...types.GrapheneObject) (types.Witnesses, error) {
+	ids := types.GrapheneObjects(witnessIDs).ToStrings()
+	resp, err := p.wsClient.CallAPI(0, "get_witnesses", ids)
+	if err != nil {
+		return nil, errors.Annotate(err, "CallAPI")
+	}
+
+	util.DDumpJSON("get_witnesses <", resp)
+
+	ret := types.Witnesses{}
+	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
+		return nil, errors.Annotate(err, "Unmarshal [Witnesses]")
+	}
+
+	return ret, nil
+}
+
+//GetCommitteeMembers returns the committee members with the given ids.
+func (p *websocketAPI) GetCommitteeMembers(committeeMemberIDs ...types.GrapheneObject) (types.CommitteeMembers, error) {
+	ids := types.GrapheneObjects(committeeMemberIDs).ToStrings()
+	resp, err := p.wsClient.CallAPI(0, "get_committee_members", ids)
+	if err != nil {
+		return nil, errors.Annotate(err, "CallAPI")
+	}
+
+	util.DDumpJSON("get_committee_members <", resp)
+
+	ret := types.CommitteeMembers{}
+	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
+		return nil, errors.Annotate(err, "Unmarshal [CommitteeMembers]")
+	}
+
+	return ret, nil
+}
+
+//GetAllWorkers returns all workers, expired ones included.
+func (p *websocketAPI) GetAllWorkers() (types.Workers, error) {
+	resp, err := p.wsClient.CallAPI(0, "get_all_workers", types.EmptyParams)
+	if err != nil {
+		return nil, errors.Annotate(err, "CallAPI")
+	}
+
+	util.DDumpJSON("get_all_workers <", resp)
+
+	ret := types.Workers{}
+	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
+		return nil, errors.Annotate(err, "Unmarshal [Workers]")
+	}
+
+	return ret, nil
+}
+
+//LookupWitnessAccounts retrieves the names of witness accounts and their WitnessIDs in alphabetical order.
+//limit must not exceed LookupVoteAccountsLimit.
+func (p *websocketAPI) LookupWitnessAccounts(lowerBoundName string, limit int) (types.WitnessLookups, error) {
+	if limit > LookupVoteAccountsLimit {
+		limit = LookupVoteAccountsLimit
+	}
+
+	resp, err := p.wsClient.CallAPI(0, "lookup_witness_accounts", lowerBoundName, limit)
+	if err != nil {
+		return nil, errors.Annotate(err, "CallAPI")
+	}
+
+	util.DDumpJSON("lookup_witness_accounts <", resp)
+
+	ret := types.WitnessLookups{}
+	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
+		return nil, errors.Annotate(err, "Unmarshal [WitnessLookups]")
+	}
+
+	return ret, nil
+}
+
+//LookupCommitteeMemberAccounts retrieves the names of committee member accounts and their
+//CommitteeMemberIDs in alphabetical order. limit must not exceed LookupVoteAccountsLimit.
+func (p *websocketAPI) LookupCommitteeMemberAccounts(lowerBoundName string, limit int) (types.CommitteeMemberLookups, error) {
+	if limit > LookupVoteAccountsLimit {
+		limit = LookupVoteAccountsLimit
+	}
+
+	resp, err := p.wsClient.CallAPI(0, "lookup_committee_member_accounts", lowerBoundName, limit)
+	if err != nil {
+		return nil, errors.Annotate(err, "CallAPI")
+	}
+
+	util.DDumpJSON("lookup_committee_member_accounts <", resp)
+
+	ret := types.CommitteeMemberLookups{}
+	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
+		return nil, errors.Annotate(err, "Unmarshal [CommitteeMemberLookups]")
+	}
+
+	return ret, nil
+}
+
+//LookupVoteIDs returns the object voted for by each of voteIDs, which is a
+//types.CommitteeMember, types.Witness or types.Worker, or nil if the vote id is unknown.
+func (p *websocketAPI) LookupVoteIDs(voteIDs ...types.VoteID) ([]interface{}, error) {
+	resp, err := p.wsClient.CallAPI(0, "lookup_vote_ids", voteIDs)
+	if err != nil {
+		return nil, errors.Annotate(err, "CallAPI")
+	}
+
+	util.DDumpJSON("lookup_vote_ids <", resp)
+
+	var data []json.RawMessage
+	if err := ffjson.Unmarshal(*resp, &data); err != nil {
+		return nil, errors.Annotate(err, "Unmarshal [RawMessage]")
+	}
+
+	if len(data) != len(voteIDs) {
+		return nil, errors.Errorf("expected %d objects, got %d", len(voteIDs), len(data))
+	}
+
+	ret := make([]interface{}, len(data))
+	for idx, obj := range data {
+		if string(obj) == "null" {
+			continue
+		}
+
+		switch voteIDs[idx].Type() {
+		case types.VoteTypeCommittee:
+			t := types.CommitteeMember{}
+			if err := t.UnmarshalJSON(obj); err != nil {
+				return nil, errors.Annotate(err, "Unmarshal [CommitteeMember]")
+			}
+			ret[idx] = t
+		case types.VoteTypeWitness:
+			t := types.Witness{}
+			if err := t.UnmarshalJSON(obj); err != nil {
+				return nil, errors.Annotate(err, "Unmarshal [Witness]")
+			}
+			ret[idx] = t
+		case types.VoteTypeWorker:
+			t := types.Worker{}
+			if err := t.UnmarshalJSON(obj); err != nil {
+				return nil, errors.Annotate(err, "Unmarshal [Worker]")
+			}
+			ret[idx] = t
+		default:
+			return nil, errors.Errorf("unknown vote type of %s", voteIDs[idx])
+		}
+	}
+
+	return ret, nil
+}
+
 //GetRequiredFees calculates the required fee for each operation by the specified asset type.
 func (p *websocketAPI) GetRequiredFees(ops types.Operations, feeAsset types.GrapheneObject) (types.AssetAmounts, error) {
 	resp, err := p.wsClient.CallAPI(0, "get_required_fees", ops.Envelopes(), feeAsset.ID())
@@ -608,11 +1196,27 @@ func (p *websocketAPI) GetRequiredFees(ops types.Operations, feeAsset types.Grap
 		return nil, errors.Annotate(err, "CallAPI")
 	}
 
-	logging.DDumpJSON("get_required_fees <", resp)
+	util.DDumpJSON("get_required_fees <", resp)
 
-	ret := types.AssetAmounts{}
-	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
-		return nil, errors.Annotate(err, "Unmarshal [AssetAmounts]")
+	var raw []json.RawMessage
+	if err := ffjson.Unmarshal(*resp, &raw); err != nil {
+		return nil, errors.Annotate(err, "Unmarshal [RawMessages]")
+	}
+
+	ret := make(types.AssetAmounts, len(raw))
+	for idx, fee := range raw {
+		//proposals return their own fee paired with the fees of the proposed operations
+		if len(fee) > 0 && fee[0] == '[' {
+			var pair []json.RawMessage
+			if err := ffjson.Unmarshal(fee, &pair); err != nil || len(pair) == 0 {
+				return nil, errors.Errorf("invalid nested fee %s", fee)
+			}
+			fee = pair[0]
+		}
+
+		if err := ffjson.Unmarshal(fee, &ret[idx]); err != nil {
+			return nil, errors.Annotate(err, "Unmarshal [AssetAmount]")
+		}
 	}
 
 	return ret, nil
@@ -629,7 +1233,7 @@ func (p *websocketAPI) GetLimitOrders(base, quote types.GrapheneObject, limit in
 		return nil, errors.Annotate(err, "CallAPI")
 	}
 
-	logging.DDumpJSON("get_limit_orders <", resp)
+	util.DDumpJSON("get_limit_orders <", resp)
 
 	ret := types.LimitOrders{}
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -640,15 +1244,15 @@ func (p *websocketAPI) GetLimitOrders(base, quote types.GrapheneObject, limit in
 }
 
 // LimitOrderCancel cancels a certain limit order given by orderID. Fees are paid in feeAsset.
-// The transaction is signed with private keys in keyBag.
-func (p *websocketAPI) LimitOrderCancel(keyBag *crypto.KeyBag, feePayingAccount, orderID, feeAsset types.GrapheneObject) error {
+// The transaction is signed with keys available in signer.
+func (p *websocketAPI) LimitOrderCancel(signer crypto.Signer, feePayingAccount, orderID, feeAsset types.GrapheneObject) error {
 	op := operations.LimitOrderCancelOperation{
 		FeePayingAccount: types.AccountIDFromObject(feePayingAccount),
 		Order:            types.LimitOrderIDFromObject(orderID),
 		Extensions:       types.Extensions{},
 	}
 
-	trx, err := p.BuildSignedTransaction(keyBag, feeAsset, &op)
+	trx, err := p.BuildSignedTransaction(signer, feeAsset, &op)
 	if err != nil {
 		return errors.Annotate(err, "BuildSignedTransaction")
 	}
@@ -667,7 +1271,7 @@ func (p *websocketAPI) GetOrderBook(base, quote types.GrapheneObject, depth int)
 		return nil, errors.Annotate(err, "CallAPI")
 	}
 
-	logging.DDumpJSON("get_order_book <", resp)
+	util.DDumpJSON("get_order_book <", resp)
 
 	ret := types.OrderBook{}
 	if err = ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -688,7 +1292,7 @@ func (p *websocketAPI) GetForceSettlementOrders(assetID types.GrapheneObject, li
 		return nil, errors.Annotate(err, "CallAPI")
 	}
 
-	logging.DDumpJSON("get_settle_orders <", resp)
+	util.DDumpJSON("get_settle_orders <", resp)
 
 	ret := types.ForceSettlementOrders{}
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -709,7 +1313,7 @@ func (p *websocketAPI) GetCallOrders(assetID types.GrapheneObject, limit int) (t
 		return nil, errors.Annotate(err, "CallAPI")
 	}
 
-	logging.DDumpJSON("get_call_orders <", resp)
+	util.DDumpJSON("get_call_orders <", resp)
 
 	ret := types.CallOrders{}
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -726,7 +1330,7 @@ func (p *websocketAPI) GetMarginPositions(accountID types.GrapheneObject) (types
 		return nil, errors.Annotate(err, "CallAPI")
 	}
 
-	logging.DDumpJSON("get_margin_positions <", resp)
+	util.DDumpJSON("get_margin_positions <", resp)
 
 	ret := types.CallOrders{}
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -747,7 +1351,7 @@ func (p *websocketAPI) GetTradeHistory(base, quote types.GrapheneObject, toTime,
 		return nil, errors.Annotate(err, "CallAPI")
 	}
 
-	logging.DDumpJSON("get_trade_history <", resp)
+	util.DDumpJSON("get_trade_history <", resp)
 
 	ret := types.MarketTrades{}
 	if err := ffjson.Unmarshal(*resp, &ret); err != nil {
@@ -764,7 +1368,7 @@ func (p *websocketAPI) GetChainID() (string, error) {
 		return "", errors.Annotate(err, "CallAPI")
 	}
 
-	logging.DDumpJSON("get_chain_id <", resp)
+	util.DDumpJSON("get_chain_id <", resp)
 
 	var id string
 	if err := ffjson.Unmarshal(*resp, &id); err != nil {
@@ -782,7 +1386,7 @@ func (p *websocketAPI) GetObjects(ids ...types.GrapheneObject) ([]interface{}, e
 		return nil, errors.Annotate(err, "CallAPI")
 	}
 
-	logging.DDumpJSON("get_objects <", resp)
+	util.DDumpJSON("get_objects <", resp)
 
 	var data []interface{}
 	if err := ffjson.Unmarshal(*resp, &data); err != nil {
@@ -807,7 +1411,6 @@ func (p *websocketAPI) GetObjects(ids ...types.GrapheneObject) ([]interface{}, e
 		// ObjectTypeBase
 		// ObjectTypeWitness
 		// ObjectTypeCustom
-		// ObjectTypeProposal
 		// ObjectTypeWithdrawPermission
 		// ObjectTypeWorker
 		switch id.SpaceType() {
@@ -867,6 +1470,12 @@ func (p *websocketAPI) GetObjects(ids ...types.GrapheneObject) ([]interface{}, e
 					return nil, errors.Annotate(err, "Unmarshal [Balance]")
 				}
 				ret = append(ret, t)
+			case types.ObjectTypeProposal:
+				t := types.Proposal{}
+				if err := t.UnmarshalJSON(b); err != nil {
+					return nil, errors.Annotate(err, "Unmarshal [Proposal]")
+				}
+				ret = append(ret, t)
 
 			default:
 				logging.DDumpUnmarshaled(id.ObjectType().String(), b)
@@ -932,8 +1541,8 @@ func (p *websocketAPI) GetObjects(ids ...types.GrapheneObject) ([]interface{}, e
 }
 
 // Transfer transfers a certain amount between two accounts. Fees are paid in feeAsset.
-// The transaction is signed with private keys in keyBag.
-func (p *websocketAPI) Transfer(keyBag *crypto.KeyBag, from, to, feeAsset types.GrapheneObject, amount types.AssetAmount, memo string) error {
+// The transaction is signed with keys available in signer.
+func (p *websocketAPI) Transfer(signer crypto.Signer, from, to, feeAsset types.GrapheneObject, amount types.AssetAmount, memo string) error {
 	op := operations.TransferOperation{
 		Amount:     amount,
 		Extensions: types.Extensions{},
@@ -943,7 +1552,7 @@ func (p *websocketAPI) Transfer(keyBag *crypto.KeyBag, from, to, feeAsset types.
 
 	if memo != "" {
 		builder := p.NewMemoBuilder(from, to, memo)
-		m, err := builder.Encrypt(keyBag)
+		m, err := builder.Encrypt(signer)
 		if err != nil {
 			return errors.Annotate(err, "Encrypt [memo]")
 		}
@@ -951,7 +1560,7 @@ func (p *websocketAPI) Transfer(keyBag *crypto.KeyBag, from, to, feeAsset types.
 		op.Memo = m
 	}
 
-	trx, err := p.BuildSignedTransaction(keyBag, feeAsset, &op)
+	trx, err := p.BuildSignedTransaction(signer, feeAsset, &op)
 	if err != nil {
 		return errors.Annotate(err, "BuildSignedTransaction")
 	}
@@ -963,6 +1572,107 @@ func (p *websocketAPI) Transfer(keyBag *crypto.KeyBag, from, to, feeAsset types.
 	return nil
 }
 
+//TransferToBlind blinds amount from the account from to a one-time key of to.
+//The returned receipt's confirmation has to reach the recipient to spend the balance.
+func (p *websocketAPI) TransferToBlind(signer crypto.Signer, from, feeAsset types.GrapheneObject, to types.PublicKey, amount types.AssetAmount) (*crypto.BlindReceipt, error) {
+	op, receipt, err := crypto.NewTransferToBlindOperation(types.AccountIDFromObject(from), to, amount)
+	if err != nil {
+		return nil, errors.Annotate(err, "NewTransferToBlindOperation")
+	}
+
+	trx, err := p.BuildSignedTransaction(signer, feeAsset, op)
+	if err != nil {
+		return nil, errors.Annotate(err, "BuildSignedTransaction")
+	}
+
+	if err := p.BroadcastTransaction(trx); err != nil {
+		return nil, errors.Annotate(err, "BroadcastTransaction")
+	}
+
+	return receipt, nil
+}
+
+//TransferFromBlind spends receipts in full to the account to, the fee is paid out of the blinded amount.
+//signer must hold the one-time keys of the receipts, see KeyBag.ReceiveStealthConfirmation.
+func (p *websocketAPI) TransferFromBlind(signer crypto.Signer, to types.GrapheneObject, receipts ...crypto.BlindReceipt) error {
+	if len(receipts) == 0 {
+		return errors.New("no blinded balances to transfer")
+	}
+
+	asset := receipts[0].Memo.Amount.Asset
+	op, err := crypto.NewTransferFromBlindOperation(types.AccountIDFromObject(to), types.AssetAmount{Asset: asset}, receipts...)
+	if err != nil {
+		return errors.Annotate(err, "NewTransferFromBlindOperation")
+	}
+
+	fees, err := p.GetRequiredFees(types.Operations{op}, &asset)
+	if err != nil {
+		return errors.Annotate(err, "GetRequiredFees")
+	}
+
+	//the fee is part of the blinded sum, so the amount depends on it
+	op, err = crypto.NewTransferFromBlindOperation(types.AccountIDFromObject(to), fees[0], receipts...)
+	if err != nil {
+		return errors.Annotate(err, "NewTransferFromBlindOperation")
+	}
+
+	trx, err := p.BuildSignedTransaction(signer, &asset, op)
+	if err != nil {
+		return errors.Annotate(err, "BuildSignedTransaction")
+	}
+
+	if err := p.BroadcastTransaction(trx); err != nil {
+		return errors.Annotate(err, "BroadcastTransaction")
+	}
+
+	return nil
+}
+
+//SignMessage signs message with the memo key of account held by signer.
+//The message carries the current head block as proof of its creation time.
+func (p *websocketAPI) SignMessage(signer crypto.Signer, account string, message string) (*crypto.SignedMessage, error) {
+	acct, err := p.GetAccountByName(account)
+	if err != nil {
+		return nil, errors.Annotate(err, "GetAccountByName")
+	}
+
+	props, err := p.GetDynamicGlobalProperties()
+	if err != nil {
+		return nil, errors.Annotate(err, "GetDynamicGlobalProperties")
+	}
+
+	msg, err := crypto.NewSignedMessage(signer, acct.Name.String(), acct.Options.MemoKey,
+		props.HeadBlockNumber, props.Time.Time, message)
+	if err != nil {
+		return nil, errors.Annotate(err, "NewSignedMessage")
+	}
+
+	return msg, nil
+}
+
+//VerifyMessage parses a signed message and verifies it was signed by the current memo key of its account.
+func (p *websocketAPI) VerifyMessage(text string) (*crypto.SignedMessage, error) {
+	msg, err := crypto.ParseSignedMessage(text)
+	if err != nil {
+		return nil, errors.Annotate(err, "ParseSignedMessage")
+	}
+
+	if err := msg.Verify(); err != nil {
+		return nil, errors.Annotate(err, "Verify")
+	}
+
+	acct, err := p.GetAccountByName(msg.Account)
+	if err != nil {
+		return nil, errors.Annotate(err, "GetAccountByName")
+	}
+
+	if !acct.Options.MemoKey.Equal(&msg.MemoKey) {
+		return nil, crypto.ErrMessageKeyMismatch
+	}
+
+	return msg, nil
+}
+
 //DatabaseAPIID returns the database API ID
 func (p *websocketAPI) DatabaseAPIID() int {
 	return p.databaseAPIID
@@ -988,6 +1698,11 @@ func (p *websocketAPI) Subscribe(apiID int, method string, fn api.SubscribeCallb
 	return p.wsClient.Subscribe(apiID, method, fn, args...)
 }
 
+//IsConnected returns true if the underlying websocket connection is established.
+func (p *websocketAPI) IsConnected() bool {
+	return p.wsClient != nil && p.wsClient.IsConnected()
+}
+
 //OnError - hook your error callback here
 func (p *websocketAPI) OnError(errorFn api.ErrorFunc) {
 	p.wsClient.OnError(errorFn)